@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"puffDep/formatter"
+	"puffDep/multicall"
+	"puffDep/puff"
+	"puffDep/rpcroute"
+	"puffDep/wallet"
+)
+
+var balancesCmd = &cobra.Command{
+	Use:   "balances",
+	Short: "Show ETH and puffETH balances of the selected wallets",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		e, err := loadEnv()
+		if err != nil {
+			return err
+		}
+
+		owners, routes := walletOwners(e.Wallets)
+		puffEth := common.HexToAddress(puff.EthPuffTokenContractAddress)
+		balances := multicall.ReadBalancesRouted(e.Pool, routes, owners, multicall.Query{Eth: true, Tokens: []common.Address{puffEth}})
+
+		var rows [][]string
+		for i, w := range e.Wallets {
+			if balances[i].Err != nil {
+				log.Printf("Failed to get balances of %s: %v", w.Address.Hex(), balances[i].Err)
+				continue
+			}
+			rows = append(rows, []string{
+				fmt.Sprint(w.Index),
+				w.Address.Hex(),
+				w.Label,
+				fmt.Sprintf("%f", formatter.ConvertWeiToEther(balances[i].Eth)),
+				fmt.Sprintf("%f", formatter.ConvertWeiToEther(balances[i].Token(puffEth))),
+			})
+		}
+		return printTable([]string{"index", "address", "label", "eth", "puffEth"}, rows)
+	},
+}
+
+// walletOwners returns the addresses of wallets with the route of each, for routed batch reads
+func walletOwners(wallets []wallet.Wallet) ([]common.Address, []rpcroute.Route) {
+	owners := make([]common.Address, len(wallets))
+	routes := make([]rpcroute.Route, len(wallets))
+	for i, w := range wallets {
+		owners[i], routes[i] = w.Address, w.Route
+	}
+	return owners, routes
+}
+
+func init() {
+	rootCmd.AddCommand(balancesCmd)
+}
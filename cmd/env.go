@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"puffDep/config"
+)
+
+var envCmd = &cobra.Command{
+	Use:   "env",
+	Short: "List the environment variables that override config fields",
+	Long: `Every config field can be overridden with an environment variable named PUFFDEP_ followed by
+the field path upper cased with dots replaced by underscores, e.g. PUFFDEP_ETHEREUM_RPC for
+ethereum.rpc. List items and map entries are addressed by index or key, like
+PUFFDEP_NOTIFY_TARGETS_0_TOKEN, and only ones that exist in the config file can be overridden.
+Lists of strings take a comma separated value.
+
+Each variable also has a _FILE variant, e.g. PUFFDEP_ETHEREUM_RPC_FILE=/run/secrets/rpc, that
+reads the value from a file so secrets don't have to be kept in config.yaml.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.Load(configPath)
+		if err != nil {
+			return err
+		}
+
+		var rows [][]string
+		for _, v := range config.EnvVars(cfg) {
+			rows = append(rows, []string{v.Name, v.Name + "_FILE", v.Field})
+		}
+		return printTable([]string{"variable", "fileVariable", "field"}, rows)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(envCmd)
+}
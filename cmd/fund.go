@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"puffDep/runner"
+	"puffDep/signer"
+	"puffDep/wallet"
+)
+
+var fundResume string
+
+var fundCmd = &cobra.Command{
+	Use:   "fund",
+	Short: "Send ETH from the master key in fund.masterKey to every selected wallet",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		e, err := loadEnv()
+		if err != nil {
+			return err
+		}
+		fund := e.Config.Fund
+		if fund.MasterKey == "" {
+			return fmt.Errorf("fund.masterKey is not set, e.g. with PUFFDEP_FUND_MASTERKEY_FILE")
+		}
+		if fund.TargetEth == 0 && len(fund.Targets) == 0 && fund.AmountRangeEth.Max == 0 {
+			return fmt.Errorf("set fund.targetEth, fund.targets or fund.amountRangeEth to know how much to send")
+		}
+		master, err := signer.Parse(fund.MasterKey, e.Signers)
+		if err != nil {
+			return fmt.Errorf("Failed to parse fund.masterKey: %v", err)
+		}
+		masterAddress := master.Address()
+
+		var workers []wallet.Wallet
+		for _, w := range e.Wallets {
+			if w.Address != masterAddress {
+				workers = append(workers, w)
+			}
+		}
+
+		serveMetrics(e.Config)
+		r, err := newRunner(e)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		funded := map[common.Address]bool{}
+		if fundResume != "" {
+			runID, records, err := runRecords(e.Config, fundResume, runner.StepFund)
+			if err != nil {
+				return err
+			}
+			r.RunID = runID
+			funded = runner.FundedWallets(records, runID)
+			fmt.Printf("Resuming run %s, %d wallets funded already\n", runID, len(funded))
+		} else {
+			fmt.Printf("Run ID: %s, pass it to --resume to continue an interrupted run\n", r.RunID)
+		}
+
+		return r.Fund(master, workers, funded)
+	},
+}
+
+func init() {
+	fundCmd.Flags().StringVar(&fundResume, "resume", "", "run ID of an interrupted fund run, or last for the latest fund run, wallets it funded are skipped")
+	rootCmd.AddCommand(fundCmd)
+}
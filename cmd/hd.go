@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"puffDep/hd"
+	"puffDep/wallet"
+)
+
+var (
+	hdCount int
+	hdWords int
+	hdOut   string
+)
+
+var hdCmd = &cobra.Command{
+	Use:   "hd",
+	Short: "Work with wallets derived from a BIP-39 mnemonic",
+}
+
+var hdListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the addresses derived from the configured mnemonic",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+		wallets, err := wallet.Derive(cfg)
+		if err != nil {
+			return err
+		}
+		filter := wallet.Filter{Addresses: walletFilter, Indexes: walletIndexes, Tags: walletTags}
+		wallets, err = filter.Apply(wallets)
+		if err != nil {
+			return err
+		}
+		return printDerived(cfg.HD.Path, wallets)
+	},
+}
+
+var hdGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate a fresh mnemonic and show its first addresses",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+		if hdCount < 0 {
+			return fmt.Errorf("--count must not be negative")
+		}
+
+		mnemonic, err := hd.NewMnemonic(hdWords)
+		if err != nil {
+			return err
+		}
+		if hdOut != "" {
+			if err := hd.WriteMnemonicFile(hdOut, mnemonic, cfg.HD.Password); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Mnemonic encrypted with hd.password and written to %s\n", hdOut)
+		} else {
+			warningText.Fprintln(os.Stderr, "Write the mnemonic down, it is shown only once:")
+			fmt.Fprintln(os.Stderr, mnemonic)
+		}
+
+		indexes := make([]int, hdCount)
+		for i := range indexes {
+			indexes[i] = i
+		}
+		wallets, err := wallet.DeriveIndexes(mnemonic, cfg.HD.Passphrase, cfg.HD.Path, indexes)
+		if err != nil {
+			return err
+		}
+		return printDerived(cfg.HD.Path, wallets)
+	},
+}
+
+// printDerived prints the index, derivation path and address of each wallet
+func printDerived(pathTemplate string, wallets []wallet.Wallet) error {
+	var rows [][]string
+	for _, w := range wallets {
+		path, err := hd.PathFor(pathTemplate, w.Index)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, []string{fmt.Sprint(w.Index), path.String(), w.Address.Hex()})
+	}
+	return printTable([]string{"index", "path", "address"}, rows)
+}
+
+func init() {
+	hdGenerateCmd.Flags().IntVar(&hdCount, "count", 10, "number of addresses to show")
+	hdGenerateCmd.Flags().IntVar(&hdWords, "words", 24, "mnemonic length, 12 or 24 words")
+	hdGenerateCmd.Flags().StringVar(&hdOut, "out", "", "write the mnemonic encrypted with hd.password to this new file instead of printing it")
+	hdCmd.AddCommand(hdListCmd, hdGenerateCmd)
+	rootCmd.AddCommand(hdCmd)
+}
@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"puffDep/config"
+	"puffDep/formatter"
+	"puffDep/history"
+	"puffDep/journal"
+	"puffDep/runner"
+)
+
+var (
+	historyRun    string
+	historyStep   string
+	historyStatus string
+	historyLimit  int
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Query the local history of runs, steps and transactions",
+}
+
+var historyRunsCmd = &cobra.Command{
+	Use:   "runs",
+	Short: "List the runs with their step counts and gas cost",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, err := openHistory()
+		if err != nil {
+			return err
+		}
+		runs, err := db.Runs()
+		if err != nil {
+			return err
+		}
+
+		var rows [][]string
+		for _, run := range runs {
+			rows = append(rows, []string{
+				run.ID,
+				run.Started.Format(time.RFC3339),
+				run.Updated.Format(time.RFC3339),
+				fmt.Sprint(run.Steps),
+				fmt.Sprint(run.Succeeded),
+				fmt.Sprint(run.Failed),
+				fmt.Sprint(run.Skipped),
+				weiToEth(run.CostWei),
+			})
+		}
+		return printTable([]string{"run", "started", "updated", "steps", "succeeded", "failed", "skipped", "costEth"}, rows)
+	},
+}
+
+var historyStepsCmd = &cobra.Command{
+	Use:   "steps",
+	Short: "List steps, e.g. --step deposit-karak -w <address>, or --run last --status failed",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, err := openHistory()
+		if err != nil {
+			return err
+		}
+		runID, err := historyRunID(db)
+		if err != nil {
+			return err
+		}
+		steps, err := db.Steps(history.Filter{
+			RunID:   runID,
+			Wallets: walletFilter,
+			Step:    historyStep,
+			Status:  historyStatus,
+			Limit:   historyLimit,
+		})
+		if err != nil {
+			return err
+		}
+
+		var rows [][]string
+		for _, s := range steps {
+			rows = append(rows, []string{
+				s.Time.Format(time.RFC3339),
+				s.RunID,
+				s.Wallet,
+				s.Step,
+				s.Status,
+				weiToEth(s.AmountWei),
+				s.TxHash,
+				weiToEth(s.Cost().String()),
+				s.Error,
+			})
+		}
+		return printTable([]string{"time", "run", "wallet", "step", "status", "amountEth", "tx", "costEth", "error"}, rows)
+	},
+}
+
+var historyReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Sum up the steps, gas cost and deposits of every wallet, e.g. --run last",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, err := openHistory()
+		if err != nil {
+			return err
+		}
+		runID, err := historyRunID(db)
+		if err != nil {
+			return err
+		}
+		r, err := db.Report(history.Filter{RunID: runID, Wallets: walletFilter})
+		if err != nil {
+			return err
+		}
+		if outputFormat == "json" {
+			return printJSON(r)
+		}
+
+		// deposits get a column per asset or vault, their amounts are in its units
+		var deposits []history.Amount
+		for _, a := range r.Totals.Amounts {
+			if a.Step == runner.StepDepositPuffer || a.Step == runner.StepDepositKarak {
+				deposits = append(deposits, a)
+			}
+		}
+		header := []string{"wallet", "steps", "succeeded", "failed", "skipped", "costEth"}
+		for _, d := range deposits {
+			header = append(header, d.Step+":"+d.Target)
+		}
+		header = append(header, "lastStep", "lastStatus", "error")
+
+		row := func(w *history.WalletReport) []string {
+			row := []string{w.Wallet, fmt.Sprint(w.Steps), fmt.Sprint(w.Succeeded), fmt.Sprint(w.Failed), fmt.Sprint(w.Skipped), weiToEth(w.CostWei)}
+			for _, d := range deposits {
+				amount := ""
+				if a := w.Amount(d.Step, d.Target); a != nil {
+					amount = weiToEth(a.Wei)
+				}
+				row = append(row, amount)
+			}
+			return append(row, w.LastStep, w.LastStatus, w.LastError)
+		}
+
+		var rows [][]string
+		for i := range r.Wallets {
+			rows = append(rows, row(&r.Wallets[i]))
+		}
+		total := row(&r.Totals)
+		total[0] = fmt.Sprintf("total (%d wallets)", len(r.Wallets))
+		return printTable(header, append(rows, total))
+	},
+}
+
+var historyTxCmd = &cobra.Command{
+	Use:   "tx <hash>",
+	Short: "Show the step that sent a transaction",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, err := openHistory()
+		if err != nil {
+			return err
+		}
+		step, err := db.Tx(args[0])
+		if err != nil {
+			return err
+		}
+		if step == nil {
+			return fmt.Errorf("transaction %s is not in the history", args[0])
+		}
+		return printJSON(step)
+	},
+}
+
+func init() {
+	flags := historyStepsCmd.Flags()
+	flags.StringVar(&historyRun, "run", "", "only steps of this run ID, or last for the most recent run")
+	flags.StringVar(&historyStep, "step", "", "only this step, e.g. deposit-karak")
+	flags.StringVar(&historyStatus, "status", "", "only this status: success, failed or skipped")
+	flags.IntVar(&historyLimit, "limit", 0, "show only the most recent steps")
+	historyReportCmd.Flags().StringVar(&historyRun, "run", "", "only steps of this run ID, or last for the most recent run")
+	historyCmd.AddCommand(historyRunsCmd, historyStepsCmd, historyReportCmd, historyTxCmd)
+	rootCmd.AddCommand(historyCmd)
+}
+
+func openHistory() (*history.DB, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.History.Path == "" {
+		return nil, fmt.Errorf("history.path is not set")
+	}
+	return history.Open(cfg.History.Path), nil
+}
+
+// historyRunID is the run of the --run flag, with last resolved to the most recent run
+func historyRunID(db *history.DB) (string, error) {
+	if historyRun != "last" {
+		return historyRun, nil
+	}
+	runID, err := db.LastRun()
+	if err != nil {
+		return "", err
+	}
+	if runID == "" {
+		return "", fmt.Errorf("the history has no runs yet")
+	}
+	return runID, nil
+}
+
+// runRecords returns the records of a run to resume, from the history when it is enabled and the
+// journal otherwise. runID "last" is the most recent run of the history with any of the steps, so
+// a deposit run doesn't resume a fund run.
+func runRecords(cfg *config.Config, runID string, steps ...string) (string, []journal.Record, error) {
+	if cfg.History.Path == "" {
+		if runID == "last" {
+			return "", nil, fmt.Errorf("--resume last needs history.path")
+		}
+		records, err := journal.Read(cfg.Journal.Path)
+		return runID, records, err
+	}
+
+	db := history.Open(cfg.History.Path)
+	if runID == "last" {
+		last, err := db.LastRun(steps...)
+		if err != nil {
+			return "", nil, err
+		}
+		if last == "" {
+			return "", nil, fmt.Errorf("the history has no %s run to resume", strings.Join(steps, "/"))
+		}
+		runID = last
+	}
+	records, err := db.Records(runID)
+	return runID, records, err
+}
+
+// weiToEth formats a decimal wei string as ETH, empty stays empty
+func weiToEth(wei string) string {
+	if wei == "" {
+		return ""
+	}
+	amount, ok := new(big.Int).SetString(wei, 10)
+	if !ok {
+		return wei
+	}
+	return fmt.Sprintf("%f", formatter.ConvertWeiToEther(amount))
+}
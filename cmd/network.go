@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"puffDep/config"
+	"puffDep/formatter"
+	"puffDep/multicall"
+	"puffDep/puff"
+	"puffDep/rpcroute"
+)
+
+// applyNetwork checks that client is on the chain of the selected network profile and points
+// the contract packages and explorer links at that network
+func applyNetwork(cfg *config.Config, client *ethclient.Client) error {
+	n, err := cfg.SelectedNetwork()
+	if err != nil {
+		return err
+	}
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		return fmt.Errorf("Failed to get chain ID: %v", err)
+	}
+	if chainID.Uint64() != n.ChainID {
+		return fmt.Errorf("rpc %s is on chain %s, but network %q expects chain %d", rpcroute.Redact(cfg.RpcUrl()), chainID, cfg.Network, n.ChainID)
+	}
+
+	if n.Contracts.PufferVault != "" {
+		puff.EthPuffTokenContractAddress = n.Contracts.PufferVault
+	}
+	if n.Contracts.Weth != "" {
+		puff.WethContractAddress = n.Contracts.Weth
+	}
+	if n.Contracts.StEth != "" {
+		puff.StEthContractAddress = n.Contracts.StEth
+	}
+	if n.Contracts.Multicall3 != "" {
+		multicall.Multicall3Address = n.Contracts.Multicall3
+	}
+	multicall.BatchSize = cfg.Multicall.BatchSize
+	if n.Explorer != "" {
+		formatter.ExplorerTxUrl = n.Explorer
+	}
+	if len(n.KarakVaults) > 0 {
+		cfg.Karak.Vaults = n.KarakVaults
+	}
+	return nil
+}
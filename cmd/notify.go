@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"puffDep/notify"
+)
+
+var notifyTestCmd = &cobra.Command{
+	Use:   "notify-test",
+	Short: "Send a sample of every notification event to the configured targets",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+		n, err := notify.New(cfg)
+		if err != nil {
+			return err
+		}
+
+		runID := "notify-test"
+		wallet := "0x0000000000000000000000000000000000000000"
+		n.Notify(notify.Event{Type: notify.EventWalletDone, RunID: runID, Wallet: wallet})
+		n.Notify(notify.Event{Type: notify.EventStepFailed, RunID: runID, Wallet: wallet, Step: "deposit-karak", Error: "sample failure"})
+		n.Notify(notify.Event{Type: notify.EventGasWait, RunID: runID, Waited: 30 * time.Minute, GasPrice: "42"})
+		n.Notify(notify.Event{Type: notify.EventRunDone, RunID: runID, Processed: 1, Failed: 1})
+		n.Close()
+
+		fmt.Printf("Sent test notifications to %d targets\n", len(cfg.Notify.Targets))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(notifyTestCmd)
+}
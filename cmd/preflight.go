@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"puffDep/preflight"
+)
+
+var skipPreflight bool
+
+var preflightCmd = &cobra.Command{
+	Use:   "preflight",
+	Short: "Check the configured contracts on chain without touching any wallet",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+		client, err := dialNetwork(cfg)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		report, err := preflight.Run(client, cfg)
+		if err != nil {
+			return err
+		}
+		report.Print()
+		return report.Err()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(preflightCmd)
+	rootCmd.PersistentFlags().BoolVar(&skipPreflight, "skip-preflight", false, "don't check the contracts on chain before working with wallets")
+}
+
+// checkPreflight runs the preflight checks before a command sends transactions and prints
+// the full report when any of them failed
+func checkPreflight(e *env) error {
+	if skipPreflight {
+		return nil
+	}
+	report, err := preflight.Run(e.Client, e.Config)
+	if err != nil {
+		return err
+	}
+	if err := report.Err(); err != nil {
+		report.Print()
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	"puffDep/formatter"
+	"puffDep/puff"
+	"puffDep/runner"
+	"puffDep/wallet"
+)
+
+var (
+	redeemPercent int
+	redeemUnwrap  bool
+)
+
+var redeemPreviewCmd = &cobra.Command{
+	Use:   "redeem-preview",
+	Short: "Show how much ETH redeeming puffETH would give and the vault's limits",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		e, err := loadEnv()
+		if err != nil {
+			return err
+		}
+
+		var rows [][]string
+		for _, w := range e.Wallets {
+			client, err := e.Pool.Client(w.Route)
+			if err != nil {
+				log.Printf("Failed to connect wallet %s: %v", w.Address.Hex(), err)
+				continue
+			}
+			puffEthBalance, err := puff.GetPuffEthBalance(client, w.Address)
+			if err != nil {
+				log.Printf("Failed to get puffEth balance of %s: %v", w.Address.Hex(), err)
+				continue
+			}
+			preview, err := puff.PreviewRedeem(client, w.Address, runner.RedeemShares(puffEthBalance, redeemPercent))
+			if err != nil {
+				log.Printf("Failed to preview redeem of %s: %v", w.Address.Hex(), err)
+				continue
+			}
+			rows = append(rows, []string{
+				fmt.Sprint(w.Index),
+				w.Address.Hex(),
+				fmt.Sprintf("%f", formatter.ConvertWeiToEther(preview.Shares)),
+				fmt.Sprintf("%f", formatter.ConvertWeiToEther(preview.MaxRedeem)),
+				fmt.Sprintf("%f", formatter.ConvertWeiToEther(preview.AssetsOut)),
+				preview.ExitFeeBasisPoints.String(),
+				fmt.Sprintf("%f", formatter.ConvertWeiToEther(preview.DailyLimitLeft)),
+			})
+		}
+		return printTable([]string{"index", "address", "puffEth", "maxRedeem", "ethOut", "exitFeeBp", "dailyLimitLeft"}, rows)
+	},
+}
+
+func checkRedeemPercent(cmd *cobra.Command, args []string) error {
+	if redeemPercent < 1 || redeemPercent > 100 {
+		return fmt.Errorf("--percent must be between 1 and 100, got %d", redeemPercent)
+	}
+	return nil
+}
+
+func init() {
+	redeemCmd := stepCommand("redeem", "Redeem puffETH back to ETH through the Puffer vault", func(r *runner.Runner, w wallet.Wallet) error {
+		return r.Redeem(w, redeemPercent, redeemUnwrap)
+	})
+	redeemCmd.PreRunE = checkRedeemPercent
+	redeemPreviewCmd.PreRunE = checkRedeemPercent
+	redeemCmd.Flags().IntVar(&redeemPercent, "percent", 100, "share of the puffETH balance to redeem")
+	redeemCmd.Flags().BoolVar(&redeemUnwrap, "unwrap", true, "unwrap the WETH paid out into ETH")
+	redeemPreviewCmd.Flags().IntVar(&redeemPercent, "percent", 100, "share of the puffETH balance to redeem")
+
+	rootCmd.AddCommand(redeemCmd, redeemPreviewCmd)
+}
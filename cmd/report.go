@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/spf13/cobra"
+	"puffDep/formatter"
+	"puffDep/karak"
+	"puffDep/report"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Show ETH, puffETH and Karak positions of every wallet with totals",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		e, err := loadEnv()
+		if err != nil {
+			return err
+		}
+
+		vaults, err := karak.Vaults(e.Config)
+		if err != nil {
+			return err
+		}
+
+		r := report.Collect(e.Pool, vaults, e.Wallets)
+		if outputFormat == "json" {
+			return printJSON(r)
+		}
+
+		ether := func(wei *big.Int) string {
+			if wei == nil {
+				return ""
+			}
+			return fmt.Sprintf("%f", formatter.ConvertWeiToEther(wei))
+		}
+
+		// vaults may hold different assets, so shares and assets get a column pair per vault
+		header := []string{"index", "address", "eth", "puffEth"}
+		for _, v := range vaults {
+			header = append(header, v.Name+"Shares", v.Name+"Assets")
+		}
+		header = append(header, "pendingNonce", "error")
+		vaultColumns := func(positions []report.VaultPosition) []string {
+			columns := make([]string, 0, 2*len(vaults))
+			for i := range vaults {
+				if i < len(positions) {
+					columns = append(columns, ether(positions[i].Shares), ether(positions[i].Assets))
+				} else {
+					columns = append(columns, "", "")
+				}
+			}
+			return columns
+		}
+
+		var rows [][]string
+		for _, p := range r.Wallets {
+			nonce := fmt.Sprint(p.PendingNonce)
+			if p.Error != "" {
+				nonce = ""
+			}
+			row := []string{fmt.Sprint(p.Index), p.Address.Hex(), ether(p.EthBalance), ether(p.PuffEthBalance)}
+			row = append(row, vaultColumns(p.Vaults)...)
+			rows = append(rows, append(row, nonce, p.Error))
+		}
+		total := []string{
+			"total",
+			fmt.Sprintf("%d wallets, %d failed", r.Totals.Wallets, r.Totals.Failed),
+			ether(r.Totals.EthBalance),
+			ether(r.Totals.PuffEthBalance),
+		}
+		total = append(total, vaultColumns(r.Totals.Vaults)...)
+		rows = append(rows, append(total, "", ""))
+		return printTable(header, rows)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"puffDep/config"
+	"puffDep/rpcroute"
+	"puffDep/signer"
+	"puffDep/wallet"
+)
+
+var warningText = color.New(color.FgYellow)
+
+// Flags shared by every command
+var (
+	configPath    string
+	networkName   string
+	keysPath      string
+	walletFilter  []string
+	walletIndexes string
+	walletTags    []string
+	outputFormat  string
+)
+
+var rootCmd = &cobra.Command{
+	Use:           "puffDep",
+	Short:         "Deposit ETH to puffETH and restake it on Karak",
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		switch outputFormat {
+		case "text", "json", "csv":
+		default:
+			return fmt.Errorf("unknown output format %q, expected text, json or csv", outputFormat)
+		}
+		return nil
+	},
+}
+
+func init() {
+	flags := rootCmd.PersistentFlags()
+	flags.StringVarP(&configPath, "config", "c", "config.yaml", "path to the config file")
+	flags.StringVarP(&networkName, "network", "n", "", "network profile to use, overrides the network in the config")
+	flags.StringVarP(&keysPath, "keys", "k", "keys.txt", "wallet file: one key per line, or a .csv or .yaml wallet list")
+	flags.StringSliceVarP(&walletFilter, "wallet", "w", nil, "only use these wallet addresses")
+	flags.StringVar(&walletIndexes, "index", "", "only use wallets with these indexes, e.g. 0-4,7")
+	flags.StringSliceVarP(&walletTags, "tag", "t", nil, "only use wallets with any of these tags")
+	flags.StringVarP(&outputFormat, "output", "o", "text", "output format: text, json or csv")
+}
+
+// Execute runs the root command
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// env is what most commands need: the config, a connected client and the selected wallets
+type env struct {
+	Config *config.Config
+	Client *ethclient.Client
+	// Pool hands out the client of each wallet's route, Client for wallets without one
+	Pool    *rpcroute.Pool
+	Wallets []wallet.Wallet
+	// Signers resolves key references like fund.masterKey the way the wallets were
+	Signers signer.Options
+}
+
+// loadConfig loads the config file, applies the --network flag and validates the result
+func loadConfig() (*config.Config, error) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading config: %v", err)
+	}
+	if networkName != "" {
+		cfg.Network = networkName
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// dialNetwork connects to the rpc of the selected network and applies its profile
+func dialNetwork(cfg *config.Config) (*ethclient.Client, error) {
+	client, err := ethclient.Dial(cfg.RpcUrl())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to connect to the Ethereum client: %v", err)
+	}
+	if err := applyNetwork(cfg, client); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+func loadEnv() (*env, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dialNetwork(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := signer.OptionsFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var wallets []wallet.Wallet
+	if cfg.HDEnabled() {
+		wallets, err = wallet.Derive(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("Error deriving wallets: %v", err)
+		}
+	} else {
+		wallets, err = wallet.Load(keysPath, cfg, signers)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading keys from file: %v", err)
+		}
+	}
+
+	filter := wallet.Filter{Addresses: walletFilter, Indexes: walletIndexes, Tags: walletTags}
+	wallets, err = filter.Apply(wallets)
+	if err != nil {
+		return nil, err
+	}
+	if len(wallets) == 0 {
+		return nil, fmt.Errorf("no wallets match the filter")
+	}
+	if err := wallet.ApplyRoutes(wallets, cfg); err != nil {
+		return nil, err
+	}
+
+	return &env{Config: cfg, Client: client, Pool: rpcroute.NewPool(client, cfg.RpcUrl()), Wallets: wallets, Signers: signers}, nil
+}
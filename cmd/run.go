@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"puffDep/config"
+	"puffDep/formatter"
+	"puffDep/history"
+	"puffDep/journal"
+	"puffDep/metrics"
+	"puffDep/notify"
+	"puffDep/relay"
+	"puffDep/rpcroute"
+	"puffDep/runner"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run the full pipeline: deposit ETH to Puffer, approve and deposit puffETH to Karak",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		e, err := loadEnv()
+		if err != nil {
+			return err
+		}
+		printConfig(e.Config)
+		if err := checkPreflight(e); err != nil {
+			return err
+		}
+		serveMetrics(e.Config)
+
+		r, err := newRunner(e)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		wallets := e.Wallets
+		if runResume != "" {
+			runID, records, err := runRecords(e.Config, runResume, config.PipelineSteps...)
+			if err != nil {
+				return err
+			}
+			wallets = r.Resume(runID, records, e.Wallets)
+			fmt.Printf("Resuming run %s, %d wallets done already\n", runID, len(e.Wallets)-len(wallets))
+		} else {
+			fmt.Printf("Run ID: %s, pass it to --resume to continue an interrupted run\n", r.RunID)
+		}
+
+		r.Run(wallets)
+		return nil
+	},
+}
+
+var runResume string
+
+func init() {
+	runCmd.Flags().StringVar(&runResume, "resume", "", "run ID of an interrupted run, or last for the latest deposit run, wallets it completed are skipped and the others pick up at the step they stopped at")
+	rootCmd.AddCommand(runCmd)
+}
+
+func printConfig(config *config.Config) {
+	fmt.Printf("App Name: %s\n", config.App.Name)
+	fmt.Printf("App Version: %s\n", config.App.Version)
+	fmt.Printf("Network: %s\n", config.Network)
+	fmt.Printf("Rpc Provider: %s\n", rpcroute.Redact(config.RpcUrl()))
+	fmt.Printf("Delays between wallets (Seconds) Min:%d / Max:%d\n", config.Ethereum.Delays.Wallet.Min, config.Ethereum.Delays.Wallet.Max)
+	fmt.Printf("Delays between blocks (Seconds) Min:%d / Max:%d\n", config.Ethereum.Delays.Block.Min, config.Ethereum.Delays.Block.Max)
+	fmt.Printf("Work Amount Range (Percent) Min:%d / Max:%d\n", config.Ethereum.Workflow.WorkAmountRangePercent.Min, config.Ethereum.Workflow.WorkAmountRangePercent.Max)
+	fmt.Printf("Gas Limit (Gwei): %d\n", config.Ethereum.Workflow.GweiLimit)
+	fmt.Printf("Deposit Asset: %s\n", config.Ethereum.Workflow.DepositAsset)
+	fmt.Printf("Steps: %s\n", strings.Join(config.Ethereum.Workflow.Steps, ", "))
+	if config.Relay.Url != "" {
+		fmt.Printf("Relay: %s (%s, public after %d blocks)\n", rpcroute.Redact(config.Relay.Url), config.Relay.Method, config.Relay.FallbackBlocks)
+	}
+}
+
+// newRunner opens the journal, sets up the notifiers and builds a runner for e.
+// The journal is rotated on SIGHUP and the operational knobs are reloaded when the config file changes.
+func newRunner(e *env) (*runner.Runner, error) {
+	n, err := notify.New(e.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	rl, err := relay.New(e.Config)
+	if err != nil {
+		return nil, err
+	}
+	if rl != nil {
+		formatter.Submitter = rl.Submit
+	}
+
+	j, err := journal.Open(e.Config.Journal.Path, e.Config.Journal.MaxSizeMB, e.Config.Journal.MaxBackups)
+	if err != nil {
+		return nil, err
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := j.Rotate(); err != nil {
+				log.Printf("Failed to rotate journal: %v", err)
+			}
+		}
+	}()
+
+	holder := config.NewHolder(e.Config)
+	config.Watch(configPath, holder)
+
+	r := runner.New(e.Client, holder, j, n)
+	// RunID is read on every wait, a resumed run reports under the ID it resumes
+	formatter.GasWaitHook = func(waited time.Duration, gasPriceGwei string) {
+		n.GasWait(r.RunID, waited, gasPriceGwei)
+	}
+	if e.Config.History.Path != "" {
+		r.History = history.Open(e.Config.History.Path)
+	}
+	return r, nil
+}
+
+// serveMetrics starts the metrics endpoint in the background if it is configured
+func serveMetrics(cfg *config.Config) {
+	if cfg.Metrics.Listen == "" {
+		return
+	}
+	fmt.Printf("Metrics: http://%s/metrics\n", cfg.Metrics.Listen)
+	go func() {
+		if err := metrics.Serve(cfg.Metrics.Listen); err != nil {
+			log.Printf("Metrics server stopped: %v", err)
+		}
+	}()
+}
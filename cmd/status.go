@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	"puffDep/formatter"
+	"puffDep/karak"
+	"puffDep/multicall"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show network state and per wallet nonces and Karak vault allowances",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		e, err := loadEnv()
+		if err != nil {
+			return err
+		}
+		ctx := context.Background()
+
+		chainID, err := e.Client.ChainID(ctx)
+		if err != nil {
+			return fmt.Errorf("Failed to get chain ID: %v", err)
+		}
+		block, err := e.Client.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("Failed to get block number: %v", err)
+		}
+		gasPrice, err := e.Client.SuggestGasPrice(ctx)
+		if err != nil {
+			return fmt.Errorf("Failed to get gas price: %v", err)
+		}
+		if outputFormat == "text" {
+			fmt.Printf("Chain ID: %s / Block: %d\n", chainID, block)
+			fmt.Printf("Gas price: %.2f Gwei / Limit: %d Gwei\n", formatter.ConvertWeiToGwei(gasPrice), e.Config.Ethereum.Workflow.GweiLimit)
+		}
+
+		vaults, err := karak.Vaults(e.Config)
+		if err != nil {
+			return err
+		}
+
+		header := []string{"index", "address", "nonce", "pendingTxs"}
+		for _, v := range vaults {
+			header = append(header, v.Name+"Allowance")
+		}
+
+		owners, routes := walletOwners(e.Wallets)
+		var query multicall.Query
+		for _, v := range vaults {
+			query.Allowances = append(query.Allowances, multicall.Allowance{Token: v.Asset, Spender: v.Address})
+		}
+		allowances := multicall.ReadBalancesRouted(e.Pool, routes, owners, query)
+
+		var rows [][]string
+		for i, w := range e.Wallets {
+			client, err := e.Pool.Client(w.Route)
+			if err != nil {
+				log.Printf("Failed to connect wallet %s: %v", w.Address.Hex(), err)
+				continue
+			}
+			nonce, err := client.NonceAt(ctx, w.Address, nil)
+			if err != nil {
+				log.Printf("Failed to get nonce of %s: %v", w.Address.Hex(), err)
+				continue
+			}
+			pendingNonce, err := client.PendingNonceAt(ctx, w.Address)
+			if err != nil {
+				log.Printf("Failed to get pending nonce of %s: %v", w.Address.Hex(), err)
+				continue
+			}
+			row := []string{
+				fmt.Sprint(w.Index),
+				w.Address.Hex(),
+				fmt.Sprint(nonce),
+				fmt.Sprint(pendingNonce - nonce),
+			}
+			if allowances[i].Err != nil {
+				log.Printf("Failed to get vault allowances of %s: %v", w.Address.Hex(), allowances[i].Err)
+				continue
+			}
+			for _, v := range vaults {
+				row = append(row, fmt.Sprintf("%f", formatter.ConvertWeiToEther(allowances[i].Allowance(v.Asset, v.Address))))
+			}
+			rows = append(rows, row)
+		}
+		return printTable(header, rows)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+	"puffDep/delayer"
+	"puffDep/runner"
+	"puffDep/wallet"
+)
+
+// stepCommand builds a command that runs a single pipeline step for every selected wallet
+func stepCommand(use, short string, step func(r *runner.Runner, w wallet.Wallet) error) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			e, err := loadEnv()
+			if err != nil {
+				return err
+			}
+			if err := checkPreflight(e); err != nil {
+				return err
+			}
+
+			serveMetrics(e.Config)
+			r, err := newRunner(e)
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+
+			for _, w := range e.Wallets {
+				delayer.WaitWhilePaused(r.Config)
+				warningText.Printf("Working with address: %s\n", w)
+				if err := step(r, w); err != nil {
+					log.Printf("%v", err)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(
+		stepCommand("deposit-puffer", "Deposit a random share of ETH, WETH or stETH into puffETH", (*runner.Runner).DepositPuffer),
+		stepCommand("approve", "Approve the Karak vaults for their share of the wallet balance", (*runner.Runner).Approve),
+		stepCommand("deposit-karak", "Deposit the wallet balance into the Karak vaults by weight", (*runner.Runner).DepositKarak),
+		stepCommand("revoke", "Reset the allowances of the Karak vaults to zero", (*runner.Runner).Revoke),
+	)
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"puffDep/runner"
+	"puffDep/wallet"
+)
+
+var sweepTo string
+
+func init() {
+	var destination common.Address
+	sweepCmd := stepCommand("sweep", "Move the ETH left in the wallets, and optionally puffETH, to sweep.destination", func(r *runner.Runner, w wallet.Wallet) error {
+		return r.Sweep(w, destination)
+	})
+	sweepCmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		to := sweepTo
+		if to == "" {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+			to = cfg.Sweep.Destination
+		}
+		if !common.IsHexAddress(to) {
+			return fmt.Errorf("sweep destination %q is not a valid address, set sweep.destination or --to", to)
+		}
+		destination = common.HexToAddress(to)
+		return nil
+	}
+	sweepCmd.Flags().StringVar(&sweepTo, "to", "", "destination address, overrides sweep.destination")
+	rootCmd.AddCommand(sweepCmd)
+}
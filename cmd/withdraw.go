@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"puffDep/formatter"
+	"puffDep/karak"
+	"puffDep/runner"
+)
+
+var withdrawStatusCmd = &cobra.Command{
+	Use:   "withdraw-status",
+	Short: "Show recorded Karak withdrawals and which of them are ready to finish",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		e, err := loadEnv()
+		if err != nil {
+			return err
+		}
+		return printWithdrawals(e)
+	},
+}
+
+func printWithdrawals(e *env) error {
+	all, err := karak.NewWithdrawalStore(e.Config.Karak.WithdrawalsFile).All()
+	if err != nil {
+		return err
+	}
+	selected := make(map[string]int)
+	for _, w := range e.Wallets {
+		selected[w.Address.Hex()] = w.Index
+	}
+
+	delay := karak.WithdrawalDelay(e.Config)
+	var rows [][]string
+	for _, w := range all {
+		index, ok := selected[w.Wallet.Hex()]
+		if !ok {
+			continue
+		}
+		maturesAt := w.Queued.MaturesAt(delay)
+		state := "pending"
+		switch {
+		case w.FinishedAt != nil:
+			state = "finished"
+		case !time.Now().Before(maturesAt):
+			state = "ready"
+		}
+		rows = append(rows, []string{
+			fmt.Sprint(index),
+			w.Wallet.Hex(),
+			w.Root.Hex(),
+			fmt.Sprintf("%f", formatter.ConvertWeiToEther(w.Queued.Request.Shares[0])),
+			maturesAt.Format(time.RFC3339),
+			state,
+		})
+	}
+	return printTable([]string{"index", "address", "root", "shares", "readyAt", "state"}, rows)
+}
+
+func init() {
+	rootCmd.AddCommand(
+		stepCommand("withdraw-start", "Queue a withdrawal of all Karak vault shares", (*runner.Runner).StartWithdraw),
+		stepCommand("withdraw-finish", "Finish the Karak withdrawals that are past the withdrawal delay", (*runner.Runner).FinishWithdraw),
+		withdrawStatusCmd,
+	)
+}
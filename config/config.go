@@ -1,7 +1,9 @@
 package config
 
 type Config struct {
-	App struct {
+	Network  string             `mapstructure:"network"`
+	Networks map[string]Network `mapstructure:"networks"`
+	App      struct {
 		Name    string `mapstructure:"name"`
 		Version string `mapstructure:"version"`
 	} `mapstructure:"app"`
@@ -18,11 +20,127 @@ type Config struct {
 			} `mapstructure:"block"`
 		} `mapstructure:"delays"`
 		Workflow struct {
-			GweiLimit              int `mapstructure:"gweiLimit"`
-			WorkAmountRangePercent struct {
-				Min int `mapstructure:"min"`
-				Max int `mapstructure:"max"`
-			} `mapstructure:"workAmountRangePercent"`
+			GweiLimit              int    `mapstructure:"gweiLimit"`
+			DepositAsset           string `mapstructure:"depositAsset"`
+			Paused                 bool   `mapstructure:"paused"`
+			WorkAmountRangePercent Range  `mapstructure:"workAmountRangePercent"`
+			// Steps are the pipeline steps run runs for every wallet
+			Steps []string `mapstructure:"steps"`
+			// Receiver gets the puffETH of deposit-puffer, empty for the wallet itself
+			Receiver string `mapstructure:"receiver"`
 		} `mapstructure:"workflow"`
 	} `mapstructure:"ethereum"`
+	Karak struct {
+		Vaults               []KarakVault `mapstructure:"vaults"`
+		WithdrawalDelayHours int          `mapstructure:"withdrawalDelayHours"`
+		WithdrawalsFile      string       `mapstructure:"withdrawalsFile"`
+	} `mapstructure:"karak"`
+	Fund struct {
+		// MasterKey is the private key the wallets are funded from, better set with PUFFDEP_FUND_MASTERKEY_FILE
+		MasterKey string `mapstructure:"masterKey"`
+		// TargetEth tops every wallet up to this balance, with 0 a random amount of AmountRangeEth is sent
+		TargetEth      float64 `mapstructure:"targetEth"`
+		AmountRangeEth struct {
+			Min float64 `mapstructure:"min"`
+			Max float64 `mapstructure:"max"`
+		} `mapstructure:"amountRangeEth"`
+		// Targets overrides TargetEth per wallet address
+		Targets map[string]float64 `mapstructure:"targets"`
+	} `mapstructure:"fund"`
+	Sweep struct {
+		// Destination receives the swept ETH and puffETH
+		Destination string `mapstructure:"destination"`
+		// MinEth is the balance below which sweeping ETH is not worth its gas
+		MinEth  float64 `mapstructure:"minEth"`
+		PuffEth bool    `mapstructure:"puffEth"`
+		// MinPuffEth is the puffETH balance below which it is left in the wallet
+		MinPuffEth float64 `mapstructure:"minPuffEth"`
+	} `mapstructure:"sweep"`
+	Routing struct {
+		// ProxiesFile has one proxy url per line, used by the wallet on the same line of the keys file
+		ProxiesFile string `mapstructure:"proxiesFile"`
+		// Wallets routes single wallets by address, overriding ProxiesFile
+		Wallets map[string]WalletRoute `mapstructure:"wallets"`
+	} `mapstructure:"routing"`
+	HD struct {
+		// Mnemonic derives the wallets instead of the keys file, better set with PUFFDEP_HD_MNEMONIC
+		Mnemonic string `mapstructure:"mnemonic"`
+		// MnemonicFile is a mnemonic encrypted with Password, written by `hd generate --out`
+		MnemonicFile string `mapstructure:"mnemonicFile"`
+		Password     string `mapstructure:"password"`
+		// Passphrase is the optional BIP-39 passphrase
+		Passphrase string `mapstructure:"passphrase"`
+		// Path is the derivation path template, {index} is replaced with the wallet index
+		Path string `mapstructure:"path"`
+		// Indexes are the wallet indexes to derive, e.g. 0-9,15
+		Indexes string `mapstructure:"indexes"`
+	} `mapstructure:"hd"`
+	Signer struct {
+		// KeystorePassword decrypts keystore:<path> keys, better set with PUFFDEP_SIGNER_KEYSTOREPASSWORD_FILE
+		KeystorePassword string `mapstructure:"keystorePassword"`
+		// RemoteUrl is the eth_signTransaction endpoint that signs for remote:<address> keys
+		RemoteUrl string `mapstructure:"remoteUrl"`
+	} `mapstructure:"signer"`
+	Relay struct {
+		// Url is the private relay transactions are sent to, empty sends them to the public mempool
+		Url string `mapstructure:"url"`
+		// Method is eth_sendPrivateTransaction or eth_sendBundle
+		Method string `mapstructure:"method"`
+		// AuthKey signs the X-Flashbots-Signature header, a random key is used when empty
+		AuthKey string `mapstructure:"authKey"`
+		// FallbackBlocks is how many blocks to wait for inclusion before sending to the public mempool
+		FallbackBlocks uint64 `mapstructure:"fallbackBlocks"`
+	} `mapstructure:"relay"`
+	Multicall struct {
+		BatchSize int `mapstructure:"batchSize"`
+	} `mapstructure:"multicall"`
+	Journal struct {
+		Path       string `mapstructure:"path"`
+		MaxSizeMB  int    `mapstructure:"maxSizeMB"`
+		MaxBackups int    `mapstructure:"maxBackups"`
+	} `mapstructure:"journal"`
+	History struct {
+		// Path is the bbolt database of runs, steps and transactions, empty disables it
+		Path string `mapstructure:"path"`
+	} `mapstructure:"history"`
+	Metrics struct {
+		Listen string `mapstructure:"listen"`
+	} `mapstructure:"metrics"`
+	Notify struct {
+		GasWaitThreshold int `mapstructure:"gasWaitThreshold"`
+		Targets          []struct {
+			Type      string            `mapstructure:"type"`
+			Url       string            `mapstructure:"url"`
+			Token     string            `mapstructure:"token"`
+			ChatID    string            `mapstructure:"chatId"`
+			Events    []string          `mapstructure:"events"`
+			Templates map[string]string `mapstructure:"templates"`
+			Retries   int               `mapstructure:"retries"`
+		} `mapstructure:"targets"`
+	} `mapstructure:"notify"`
+}
+
+// HDEnabled is true when the wallets are derived from a mnemonic instead of read from the keys file
+func (c *Config) HDEnabled() bool {
+	return c.HD.Mnemonic != "" || c.HD.MnemonicFile != ""
+}
+
+// Range is an inclusive min / max pair
+type Range struct {
+	Min int `mapstructure:"min" yaml:"min"`
+	Max int `mapstructure:"max" yaml:"max"`
+}
+
+type KarakVault struct {
+	Name       string `mapstructure:"name"`
+	Supervisor string `mapstructure:"supervisor"`
+	Vault      string `mapstructure:"vault"`
+	Asset      string `mapstructure:"asset"`
+	Weight     int    `mapstructure:"weight"`
+}
+
+// WalletRoute is a wallet's own rpc endpoint and/or the http or socks5 proxy it connects through
+type WalletRoute struct {
+	Rpc   string `mapstructure:"rpc"`
+	Proxy string `mapstructure:"proxy"`
 }
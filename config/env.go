@@ -0,0 +1,152 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EnvPrefix prefixes every environment variable that overrides a config field. The rest of the name
+// is the field path upper cased with dots replaced by underscores, list items and map entries are
+// addressed by index or key: ethereum.rpc is PUFFDEP_ETHEREUM_RPC and the token of the first notify
+// target is PUFFDEP_NOTIFY_TARGETS_0_TOKEN. Every variable has a _FILE variant that reads the value
+// from a file instead, for secrets mounted into a container.
+const EnvPrefix = "PUFFDEP"
+
+// EnvVar is one environment variable that overrides a config field
+type EnvVar struct {
+	Name  string
+	Field string
+}
+
+// envName turns a field path into the name of its environment variable
+func envName(path []string) string {
+	return EnvPrefix + "_" + strings.ToUpper(strings.Join(path, "_"))
+}
+
+// lookupEnv returns the value for path from NAME, or from the file named by NAME_FILE
+func lookupEnv(path []string) (string, bool, error) {
+	name := envName(path)
+	if value, ok := os.LookupEnv(name); ok {
+		return value, true, nil
+	}
+	if file, ok := os.LookupEnv(name + "_FILE"); ok {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", false, fmt.Errorf("%s_FILE: %v", name, err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), true, nil
+	}
+	return "", false, nil
+}
+
+// walkFields calls leaf for every settable leaf field of v. Map entries are copied, passed through
+// and written back so they can be changed as well.
+func walkFields(v reflect.Value, path []string, leaf func(path []string, field reflect.Value) error) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			tag := strings.Split(t.Field(i).Tag.Get("mapstructure"), ",")[0]
+			if tag == "" || tag == "-" {
+				continue
+			}
+			if err := walkFields(v.Field(i), append(path[:len(path):len(path)], tag), leaf); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Struct {
+			return leaf(path, v)
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := walkFields(v.Index(i), append(path[:len(path):len(path)], strconv.Itoa(i)), leaf); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			entry := reflect.New(v.Type().Elem()).Elem()
+			entry.Set(v.MapIndex(key))
+			if err := walkFields(entry, append(path[:len(path):len(path)], key.String()), leaf); err != nil {
+				return err
+			}
+			v.SetMapIndex(key, entry)
+		}
+	default:
+		return leaf(path, v)
+	}
+	return nil
+}
+
+func setField(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// applyEnv overrides config fields from PUFFDEP_* environment variables. Only list items and map
+// entries that exist in the config file can be overridden.
+func applyEnv(cfg *Config) error {
+	return walkFields(reflect.ValueOf(cfg).Elem(), nil, func(path []string, field reflect.Value) error {
+		value, ok, err := lookupEnv(path)
+		if err != nil || !ok {
+			return err
+		}
+		if err := setField(field, value); err != nil {
+			return fmt.Errorf("%s: %v", envName(path), err)
+		}
+		return nil
+	})
+}
+
+// EnvVars lists the environment variables that can override the fields of cfg
+func EnvVars(cfg *Config) []EnvVar {
+	var vars []EnvVar
+	walkFields(reflect.ValueOf(cfg).Elem(), nil, func(path []string, field reflect.Value) error {
+		vars = append(vars, EnvVar{Name: envName(path), Field: strings.Join(path, ".")})
+		return nil
+	})
+	return vars
+}
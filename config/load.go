@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+)
+
+// Load reads the yaml config at path into a Config and applies the PUFFDEP_* environment overrides
+func Load(path string) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if filepath.Ext(path) == "" {
+		v.SetConfigType("yaml")
+	}
+	v.SetDefault("network", "mainnet")
+	v.SetDefault("networks.mainnet", map[string]interface{}{
+		"chainId":     1,
+		"explorer":    "https://etherscan.io/tx/{hash}",
+		"puffEthName": "pufETH",
+		"contracts": map[string]interface{}{
+			"pufferVault": "0xD9A442856C234a39a81a089C06451EBAa4306a72",
+			"weth":        "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+			"stEth":       "0xae7ab96520DE3A18E5e111B5EaAb095312D7fE84",
+			"multicall3":  "0xcA11bde05977b3631167028862bE2a173976CA11",
+		},
+	})
+	v.SetDefault("ethereum.workflow.depositAsset", "auto")
+	v.SetDefault("ethereum.workflow.steps", PipelineSteps)
+	v.SetDefault("multicall.batchSize", 500)
+	v.SetDefault("hd.path", "m/44'/60'/0'/0/{index}")
+	v.SetDefault("hd.indexes", "0-9")
+	v.SetDefault("relay.method", "eth_sendPrivateTransaction")
+	v.SetDefault("relay.fallbackBlocks", 25)
+	v.SetDefault("journal.path", "journal.jsonl")
+	v.SetDefault("history.path", "history.db")
+	v.SetDefault("karak.vaults", []map[string]interface{}{{
+		"name":       "puffETH",
+		"supervisor": "0x54e44DbB92dBA848ACe27F44c0CB4268981eF1CC",
+		"vault":      "0x68754d29f2e97B837Cb622ccfF325adAC27E9977",
+		"asset":      "0xD9A442856C234a39a81a089C06451EBAa4306a72",
+		"weight":     100,
+	}})
+	v.SetDefault("karak.withdrawalDelayHours", 168)
+	v.SetDefault("karak.withdrawalsFile", "withdrawals.json")
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("Error reading config file, %s", err)
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("Unable to decode into struct, %v", err)
+	}
+	if err := applyEnv(&cfg); err != nil {
+		return nil, fmt.Errorf("Error reading environment overrides, %v", err)
+	}
+
+	return &cfg, nil
+}
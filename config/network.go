@@ -0,0 +1,39 @@
+package config
+
+import "fmt"
+
+// Network is a chain profile: where to connect, which contracts to use and how to link transactions
+type Network struct {
+	ChainID uint64 `mapstructure:"chainId"`
+	// Rpc overrides ethereum.rpc when set
+	Rpc string `mapstructure:"rpc"`
+	// Explorer is the transaction link template, {hash} is replaced with the transaction hash
+	Explorer  string `mapstructure:"explorer"`
+	Contracts struct {
+		PufferVault string `mapstructure:"pufferVault"`
+		Weth        string `mapstructure:"weth"`
+		StEth       string `mapstructure:"stEth"`
+		Multicall3  string `mapstructure:"multicall3"`
+	} `mapstructure:"contracts"`
+	// PuffEthName is the name() the Puffer vault token has to report, checked before a run
+	PuffEthName string `mapstructure:"puffEthName"`
+	// KarakVaults overrides karak.vaults when set
+	KarakVaults []KarakVault `mapstructure:"karakVaults"`
+}
+
+// SelectedNetwork returns the profile named by the network field
+func (c *Config) SelectedNetwork() (*Network, error) {
+	n, ok := c.Networks[c.Network]
+	if !ok {
+		return nil, fmt.Errorf("network %q is not defined in networks", c.Network)
+	}
+	return &n, nil
+}
+
+// RpcUrl is the rpc of the selected network, falling back to ethereum.rpc
+func (c *Config) RpcUrl() string {
+	if n, err := c.SelectedNetwork(); err == nil && n.Rpc != "" {
+		return n.Rpc
+	}
+	return c.Ethereum.Rpc
+}
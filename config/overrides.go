@@ -0,0 +1,114 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PipelineSteps are the steps of run, in the order they are run
+var PipelineSteps = []string{"deposit-puffer", "approve", "deposit-karak"}
+
+// Overrides are the workflow settings of a single wallet, unset fields keep the global value
+type Overrides struct {
+	WorkAmountRangePercent *Range `yaml:"workAmountRangePercent"`
+	DepositAsset           string `yaml:"depositAsset"`
+	// KarakVault is the name or address of the only Karak vault the wallet deposits into
+	KarakVault string   `yaml:"karakVault"`
+	GweiLimit  int      `yaml:"gweiLimit"`
+	Steps      []string `yaml:"steps"`
+	Receiver   string   `yaml:"receiver"`
+}
+
+func (o Overrides) IsZero() bool {
+	return o.WorkAmountRangePercent == nil && o.DepositAsset == "" && o.KarakVault == "" &&
+		o.GweiLimit == 0 && len(o.Steps) == 0 && o.Receiver == ""
+}
+
+// String lists the set fields, e.g. "gweiLimit 5, steps deposit-puffer"
+func (o Overrides) String() string {
+	var parts []string
+	if r := o.WorkAmountRangePercent; r != nil {
+		parts = append(parts, fmt.Sprintf("workAmountRangePercent %d-%d", r.Min, r.Max))
+	}
+	if o.DepositAsset != "" {
+		parts = append(parts, "depositAsset "+o.DepositAsset)
+	}
+	if o.KarakVault != "" {
+		parts = append(parts, "karakVault "+o.KarakVault)
+	}
+	if o.GweiLimit != 0 {
+		parts = append(parts, fmt.Sprintf("gweiLimit %d", o.GweiLimit))
+	}
+	if len(o.Steps) > 0 {
+		parts = append(parts, "steps "+strings.Join(o.Steps, " "))
+	}
+	if o.Receiver != "" {
+		parts = append(parts, "receiver "+o.Receiver)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// WithOverrides returns a copy of the config with the overrides merged on top
+func (c *Config) WithOverrides(o Overrides) *Config {
+	merged := *c
+	workflow := &merged.Ethereum.Workflow
+	if o.WorkAmountRangePercent != nil {
+		workflow.WorkAmountRangePercent = *o.WorkAmountRangePercent
+	}
+	if o.DepositAsset != "" {
+		workflow.DepositAsset = o.DepositAsset
+	}
+	if o.GweiLimit != 0 {
+		workflow.GweiLimit = o.GweiLimit
+	}
+	if len(o.Steps) > 0 {
+		workflow.Steps = o.Steps
+	}
+	if o.Receiver != "" {
+		workflow.Receiver = o.Receiver
+	}
+	if o.KarakVault != "" {
+		merged.Karak.Vaults = nil
+		for _, v := range c.Karak.Vaults {
+			if v.Name == o.KarakVault || strings.EqualFold(v.Vault, o.KarakVault) {
+				merged.Karak.Vaults = []KarakVault{v}
+				break
+			}
+		}
+	}
+	return &merged
+}
+
+// ValidateOverrides checks the overrides against the config they are merged onto and returns
+// every problem, prefixed with its field
+func (c *Config) ValidateOverrides(o Overrides) []string {
+	v := &validator{}
+	if r := o.WorkAmountRangePercent; r != nil {
+		v.rangeOf("workAmountRangePercent", r.Min, r.Max, 1, 100)
+	}
+	if o.DepositAsset != "" {
+		v.depositAsset("depositAsset", o.DepositAsset)
+	}
+	if o.GweiLimit < 0 {
+		v.fail("gweiLimit", "must be positive, got %d", o.GweiLimit)
+	}
+	if len(o.Steps) > 0 {
+		v.steps("steps", o.Steps)
+	}
+	if o.Receiver != "" {
+		v.address("receiver", o.Receiver)
+	}
+	if o.KarakVault != "" && len(c.WithOverrides(Overrides{KarakVault: o.KarakVault}).Karak.Vaults) == 0 {
+		v.fail("karakVault", "no karak vault is named %q or has that address", o.KarakVault)
+	}
+	return v.problems
+}
+
+// With returns a holder whose Get merges the overrides onto the current config of h, so reloads
+// of h apply to it too
+func (h *Holder) With(o Overrides) *Holder {
+	if o.IsZero() {
+		return h
+	}
+	return &Holder{parent: h, overrides: o}
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+// Holder gives concurrent readers the current config while Watch swaps in reloaded versions.
+// A *Config returned by Get is never modified, read it again to see later changes.
+type Holder struct {
+	mu  sync.RWMutex
+	cfg *Config
+
+	// parent and overrides are set on holders made by With
+	parent    *Holder
+	overrides Overrides
+}
+
+func NewHolder(cfg *Config) *Holder {
+	return &Holder{cfg: cfg}
+}
+
+func (h *Holder) Get() *Config {
+	if h.parent != nil {
+		return h.parent.Get().WithOverrides(h.overrides)
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.cfg
+}
+
+// reloadable are the operational knobs that may change while running, everything else needs a restart
+var reloadable = []struct {
+	field string
+	value func(c *Config) interface{}
+	apply func(dst *Config, src *Config)
+}{
+	{"ethereum.workflow.gweiLimit",
+		func(c *Config) interface{} { return c.Ethereum.Workflow.GweiLimit },
+		func(dst *Config, src *Config) { dst.Ethereum.Workflow.GweiLimit = src.Ethereum.Workflow.GweiLimit }},
+	{"ethereum.workflow.paused",
+		func(c *Config) interface{} { return c.Ethereum.Workflow.Paused },
+		func(dst *Config, src *Config) { dst.Ethereum.Workflow.Paused = src.Ethereum.Workflow.Paused }},
+	{"ethereum.workflow.workAmountRangePercent",
+		func(c *Config) interface{} { return c.Ethereum.Workflow.WorkAmountRangePercent },
+		func(dst *Config, src *Config) {
+			dst.Ethereum.Workflow.WorkAmountRangePercent = src.Ethereum.Workflow.WorkAmountRangePercent
+		}},
+	{"ethereum.delays.wallet",
+		func(c *Config) interface{} { return c.Ethereum.Delays.Wallet },
+		func(dst *Config, src *Config) { dst.Ethereum.Delays.Wallet = src.Ethereum.Delays.Wallet }},
+	{"ethereum.delays.block",
+		func(c *Config) interface{} { return c.Ethereum.Delays.Block },
+		func(dst *Config, src *Config) { dst.Ethereum.Delays.Block = src.Ethereum.Delays.Block }},
+}
+
+// reload copies the reloadable fields of fresh onto a copy of the current config, validates it
+// and swaps it in. Changes to other fields are ignored.
+func (h *Holder) reload(fresh *Config) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	next := *h.cfg
+	var changes []string
+	for _, r := range reloadable {
+		before, after := r.value(h.cfg), r.value(fresh)
+		if fmt.Sprint(before) == fmt.Sprint(after) {
+			continue
+		}
+		r.apply(&next, fresh)
+		changes = append(changes, fmt.Sprintf("%s %v -> %v", r.field, before, after))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if err := next.Validate(); err != nil {
+		return err
+	}
+	h.cfg = &next
+	for _, c := range changes {
+		log.Printf("Config reloaded: %s", c)
+	}
+	return nil
+}
+
+// Watch reloads the reloadable fields into h whenever the config file at path changes.
+// Events are debounced so an editor's write in several steps is read once it is complete.
+func Watch(path string, h *Holder) {
+	var mu sync.Mutex
+	var timer *time.Timer
+	reload := func() {
+		fresh, err := Load(path)
+		if err != nil {
+			log.Printf("Config reload failed, keeping the current config: %v", err)
+			return
+		}
+		if err := h.reload(fresh); err != nil {
+			log.Printf("Config reload rejected, keeping the current config: %v", err)
+		}
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.OnConfigChange(func(e fsnotify.Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(500*time.Millisecond, reload)
+	})
+	v.WatchConfig()
+}
@@ -0,0 +1,329 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ValidationError lists every problem found in a config, each prefixed with its field path
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid config:\n  %s", strings.Join(e.Problems, "\n  "))
+}
+
+type validator struct {
+	problems []string
+}
+
+func (v *validator) fail(field string, format string, args ...interface{}) {
+	v.problems = append(v.problems, field+": "+fmt.Sprintf(format, args...))
+}
+
+func (v *validator) url(field string, value string, schemes ...string) {
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		v.fail(field, "%q is not a valid url", value)
+		return
+	}
+	for _, s := range schemes {
+		if u.Scheme == s {
+			return
+		}
+	}
+	v.fail(field, "scheme must be one of %s, got %q", strings.Join(schemes, ", "), u.Scheme)
+}
+
+func (v *validator) address(field string, value string) {
+	if !common.IsHexAddress(value) {
+		v.fail(field, "%q is not a valid address", value)
+	}
+}
+
+func (v *validator) rangeOf(field string, min int, max int, lowest int, highest int) {
+	if min < lowest {
+		v.fail(field+".min", "must be at least %d, got %d", lowest, min)
+	}
+	if max > highest {
+		v.fail(field+".max", "must be at most %d, got %d", highest, max)
+	}
+	if min > max {
+		v.fail(field, "min %d is greater than max %d", min, max)
+	}
+}
+
+func (v *validator) karakVaults(field string, vaults []KarakVault) {
+	for i, kv := range vaults {
+		path := fmt.Sprintf("%s[%d]", field, i)
+		v.address(path+".supervisor", kv.Supervisor)
+		v.address(path+".vault", kv.Vault)
+		v.address(path+".asset", kv.Asset)
+		if kv.Weight <= 0 {
+			v.fail(path+".weight", "must be positive, got %d", kv.Weight)
+		}
+	}
+}
+
+func (v *validator) depositAsset(field string, value string) {
+	switch value {
+	case "auto", "eth", "weth", "steth":
+	default:
+		v.fail(field, "must be auto, eth, weth or steth, got %q", value)
+	}
+}
+
+func (v *validator) steps(field string, steps []string) {
+	if len(steps) == 0 {
+		v.fail(field, "must list at least one of %s", strings.Join(PipelineSteps, ", "))
+	}
+	for _, step := range steps {
+		known := false
+		for _, s := range PipelineSteps {
+			known = known || s == step
+		}
+		if !known {
+			v.fail(field, "unknown step %q, expected one of %s", step, strings.Join(PipelineSteps, ", "))
+		}
+	}
+}
+
+var rpcSchemes = []string{"http", "https", "ws", "wss"}
+
+var proxySchemes = []string{"http", "https", "socks5"}
+
+// Validate checks ranges, urls, addresses and required fields and reports every problem at once
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	//! Network
+	n, ok := c.Networks[c.Network]
+	if !ok {
+		v.fail("network", "%q is not defined in networks", c.Network)
+	}
+	names := make([]string, 0, len(c.Networks))
+	for name := range c.Networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		profile := c.Networks[name]
+		path := "networks." + name
+		if profile.ChainID == 0 {
+			v.fail(path+".chainId", "is required")
+		}
+		if profile.Rpc != "" {
+			v.url(path+".rpc", profile.Rpc, rpcSchemes...)
+		}
+		if profile.Explorer != "" {
+			if !strings.Contains(profile.Explorer, "{hash}") {
+				v.fail(path+".explorer", "must contain {hash}")
+			}
+			v.url(path+".explorer", strings.ReplaceAll(profile.Explorer, "{hash}", "0x"), "http", "https")
+		}
+		for _, contract := range [][2]string{{"pufferVault", profile.Contracts.PufferVault}, {"weth", profile.Contracts.Weth}, {"stEth", profile.Contracts.StEth}, {"multicall3", profile.Contracts.Multicall3}} {
+			if contract[1] != "" {
+				v.address(path+".contracts."+contract[0], contract[1])
+			}
+		}
+		v.karakVaults(path+".karakVaults", profile.KarakVaults)
+	}
+
+	//! Ethereum
+	if !ok || n.Rpc == "" {
+		if c.Ethereum.Rpc == "" {
+			v.fail("ethereum.rpc", "is required")
+		} else {
+			v.url("ethereum.rpc", c.Ethereum.Rpc, rpcSchemes...)
+		}
+	}
+	v.rangeOf("ethereum.delays.wallet", c.Ethereum.Delays.Wallet.Min, c.Ethereum.Delays.Wallet.Max, 0, 1<<31-1)
+	v.rangeOf("ethereum.delays.block", c.Ethereum.Delays.Block.Min, c.Ethereum.Delays.Block.Max, 0, 1<<31-1)
+	v.rangeOf("ethereum.workflow.workAmountRangePercent", c.Ethereum.Workflow.WorkAmountRangePercent.Min, c.Ethereum.Workflow.WorkAmountRangePercent.Max, 1, 100)
+	if c.Ethereum.Workflow.GweiLimit <= 0 {
+		v.fail("ethereum.workflow.gweiLimit", "must be positive, got %d", c.Ethereum.Workflow.GweiLimit)
+	}
+	v.depositAsset("ethereum.workflow.depositAsset", c.Ethereum.Workflow.DepositAsset)
+	v.steps("ethereum.workflow.steps", c.Ethereum.Workflow.Steps)
+	if c.Ethereum.Workflow.Receiver != "" {
+		v.address("ethereum.workflow.receiver", c.Ethereum.Workflow.Receiver)
+	}
+
+	//! Karak
+	if len(c.Karak.Vaults) == 0 && (!ok || len(n.KarakVaults) == 0) {
+		v.fail("karak.vaults", "at least one vault is required")
+	}
+	v.karakVaults("karak.vaults", c.Karak.Vaults)
+	if c.Karak.WithdrawalDelayHours < 0 {
+		v.fail("karak.withdrawalDelayHours", "must not be negative, got %d", c.Karak.WithdrawalDelayHours)
+	}
+	if c.Karak.WithdrawalsFile == "" {
+		v.fail("karak.withdrawalsFile", "is required")
+	}
+
+	//! Fund
+	if c.Fund.TargetEth < 0 {
+		v.fail("fund.targetEth", "must not be negative, got %g", c.Fund.TargetEth)
+	}
+	if c.Fund.AmountRangeEth.Min < 0 {
+		v.fail("fund.amountRangeEth.min", "must not be negative, got %g", c.Fund.AmountRangeEth.Min)
+	}
+	if c.Fund.AmountRangeEth.Min > c.Fund.AmountRangeEth.Max {
+		v.fail("fund.amountRangeEth", "min %g is greater than max %g", c.Fund.AmountRangeEth.Min, c.Fund.AmountRangeEth.Max)
+	}
+	fundTargets := make([]string, 0, len(c.Fund.Targets))
+	for address := range c.Fund.Targets {
+		fundTargets = append(fundTargets, address)
+	}
+	sort.Strings(fundTargets)
+	for _, address := range fundTargets {
+		v.address("fund.targets", address)
+		if c.Fund.Targets[address] < 0 {
+			v.fail("fund.targets."+address, "must not be negative, got %g", c.Fund.Targets[address])
+		}
+	}
+
+	//! Sweep
+	if c.Sweep.Destination != "" {
+		v.address("sweep.destination", c.Sweep.Destination)
+	}
+	if c.Sweep.MinEth < 0 {
+		v.fail("sweep.minEth", "must not be negative, got %g", c.Sweep.MinEth)
+	}
+	if c.Sweep.MinPuffEth < 0 {
+		v.fail("sweep.minPuffEth", "must not be negative, got %g", c.Sweep.MinPuffEth)
+	}
+
+	//! Routing
+	routed := make([]string, 0, len(c.Routing.Wallets))
+	for address := range c.Routing.Wallets {
+		routed = append(routed, address)
+	}
+	sort.Strings(routed)
+	for _, address := range routed {
+		route := c.Routing.Wallets[address]
+		path := "routing.wallets." + address
+		v.address(path, address)
+		if route.Rpc != "" {
+			v.url(path+".rpc", route.Rpc, rpcSchemes...)
+		}
+		if route.Proxy != "" {
+			v.url(path+".proxy", route.Proxy, proxySchemes...)
+		}
+	}
+
+	//! HD wallets
+	if c.HD.Mnemonic != "" && c.HD.MnemonicFile != "" {
+		v.fail("hd", "set either mnemonic or mnemonicFile, not both")
+	}
+	if c.HD.MnemonicFile != "" && c.HD.Password == "" {
+		v.fail("hd.password", "is required to decrypt mnemonicFile")
+	}
+	if !strings.Contains(c.HD.Path, "{index}") {
+		v.fail("hd.path", "%q has no {index}", c.HD.Path)
+	} else if _, err := accounts.ParseDerivationPath(strings.ReplaceAll(c.HD.Path, "{index}", "0")); err != nil {
+		v.fail("hd.path", "%q is not a valid derivation path", c.HD.Path)
+	}
+
+	//! Signer
+	if c.Signer.RemoteUrl != "" {
+		v.url("signer.remoteUrl", c.Signer.RemoteUrl, rpcSchemes...)
+	}
+
+	//! Relay
+	if c.Relay.Url != "" {
+		v.url("relay.url", c.Relay.Url, "http", "https")
+		switch c.Relay.Method {
+		case "eth_sendPrivateTransaction", "eth_sendBundle":
+		default:
+			v.fail("relay.method", "must be eth_sendPrivateTransaction or eth_sendBundle, got %q", c.Relay.Method)
+		}
+		if c.Relay.AuthKey != "" {
+			if _, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(c.Relay.AuthKey), "0x")); err != nil {
+				v.fail("relay.authKey", "is not a valid private key")
+			}
+		}
+		if c.Relay.FallbackBlocks == 0 {
+			v.fail("relay.fallbackBlocks", "must be positive")
+		}
+	}
+
+	//! Multicall
+	if c.Multicall.BatchSize <= 0 {
+		v.fail("multicall.batchSize", "must be positive, got %d", c.Multicall.BatchSize)
+	}
+
+	//! Journal
+	if c.Journal.Path == "" {
+		v.fail("journal.path", "is required")
+	}
+	if c.Journal.MaxSizeMB < 0 {
+		v.fail("journal.maxSizeMB", "must not be negative, got %d", c.Journal.MaxSizeMB)
+	}
+	if c.Journal.MaxBackups < 0 {
+		v.fail("journal.maxBackups", "must not be negative, got %d", c.Journal.MaxBackups)
+	}
+
+	//! Metrics
+	if c.Metrics.Listen != "" {
+		if _, _, err := net.SplitHostPort(c.Metrics.Listen); err != nil {
+			v.fail("metrics.listen", "%q is not a host:port", c.Metrics.Listen)
+		}
+	}
+
+	//! Notify
+	if c.Notify.GasWaitThreshold < 0 {
+		v.fail("notify.gasWaitThreshold", "must not be negative, got %d", c.Notify.GasWaitThreshold)
+	}
+	events := map[string]bool{"wallet_done": true, "step_failed": true, "gas_wait": true, "run_done": true}
+	for i, t := range c.Notify.Targets {
+		path := fmt.Sprintf("notify.targets[%d]", i)
+		switch t.Type {
+		case "webhook", "slack":
+			v.url(path+".url", t.Url, "http", "https")
+		case "telegram":
+			if t.Url != "" {
+				v.url(path+".url", t.Url, "http", "https")
+			}
+			if t.Token == "" {
+				v.fail(path+".token", "is required for telegram")
+			}
+			if t.ChatID == "" {
+				v.fail(path+".chatId", "is required for telegram")
+			}
+		default:
+			v.fail(path+".type", "must be webhook, slack or telegram, got %q", t.Type)
+		}
+		for _, e := range t.Events {
+			if !events[e] {
+				v.fail(path+".events", "unknown event %q", e)
+			}
+		}
+		templates := make([]string, 0, len(t.Templates))
+		for e := range t.Templates {
+			templates = append(templates, e)
+		}
+		sort.Strings(templates)
+		for _, e := range templates {
+			if !events[e] {
+				v.fail(path+".templates", "unknown event %q", e)
+			}
+		}
+		if t.Retries < 0 {
+			v.fail(path+".retries", "must not be negative, got %d", t.Retries)
+		}
+	}
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
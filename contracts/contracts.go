@@ -0,0 +1,14 @@
+// Package contracts holds the ABIs of the contracts we talk to, in abi/, and the typed
+// bindings generated from them with abigen. Run `go generate ./contracts` after changing an ABI.
+//
+// abigen runs at the go-ethereum version of go.mod, tools.go keeps its dependencies in go.sum.
+// -checklinkname=0 lets github.com/fjl/memsize, which abigen pulls in, link with Go 1.23 and later.
+package contracts
+
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen --abi abi/PufferVault.json --pkg puffervault --type PufferVault --out puffervault/puffervault.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen --abi abi/VaultSupervisor.json --pkg vaultsupervisor --type VaultSupervisor --out vaultsupervisor/vaultsupervisor.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen --abi abi/KarakVault.json --pkg karakvault --type KarakVault --out karakvault/karakvault.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen --abi abi/ERC20.json --pkg erc20 --type ERC20 --out erc20/erc20.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen --abi abi/WETH.json --pkg weth --type WETH --out weth/weth.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen --abi abi/StETH.json --pkg steth --type StETH --out steth/steth.go
+//go:generate go run -ldflags=-checklinkname=0 github.com/ethereum/go-ethereum/cmd/abigen --abi abi/Multicall3.json --pkg multicall3 --type Multicall3 --out multicall3/multicall3.go
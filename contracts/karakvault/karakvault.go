@@ -0,0 +1,1513 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package karakvault
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// KarakVaultMetaData contains all meta data concerning the KarakVault contract.
+var KarakVaultMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"asset\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalAssets\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"name\":\"convertToShares\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"convertToAssets\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"maxDeposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"maxAssets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"maxMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"maxShares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"maxWithdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"maxAssets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"maxRedeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"maxShares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"name\":\"previewDeposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"previewMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"name\":\"previewWithdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"previewRedeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"withdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"redeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"Withdraw\",\"type\":\"event\"}]",
+}
+
+// KarakVaultABI is the input ABI used to generate the binding from.
+// Deprecated: Use KarakVaultMetaData.ABI instead.
+var KarakVaultABI = KarakVaultMetaData.ABI
+
+// KarakVault is an auto generated Go binding around an Ethereum contract.
+type KarakVault struct {
+	KarakVaultCaller     // Read-only binding to the contract
+	KarakVaultTransactor // Write-only binding to the contract
+	KarakVaultFilterer   // Log filterer for contract events
+}
+
+// KarakVaultCaller is an auto generated read-only Go binding around an Ethereum contract.
+type KarakVaultCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// KarakVaultTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type KarakVaultTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// KarakVaultFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type KarakVaultFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// KarakVaultSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type KarakVaultSession struct {
+	Contract     *KarakVault       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// KarakVaultCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type KarakVaultCallerSession struct {
+	Contract *KarakVaultCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// KarakVaultTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type KarakVaultTransactorSession struct {
+	Contract     *KarakVaultTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// KarakVaultRaw is an auto generated low-level Go binding around an Ethereum contract.
+type KarakVaultRaw struct {
+	Contract *KarakVault // Generic contract binding to access the raw methods on
+}
+
+// KarakVaultCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type KarakVaultCallerRaw struct {
+	Contract *KarakVaultCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// KarakVaultTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type KarakVaultTransactorRaw struct {
+	Contract *KarakVaultTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewKarakVault creates a new instance of KarakVault, bound to a specific deployed contract.
+func NewKarakVault(address common.Address, backend bind.ContractBackend) (*KarakVault, error) {
+	contract, err := bindKarakVault(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &KarakVault{KarakVaultCaller: KarakVaultCaller{contract: contract}, KarakVaultTransactor: KarakVaultTransactor{contract: contract}, KarakVaultFilterer: KarakVaultFilterer{contract: contract}}, nil
+}
+
+// NewKarakVaultCaller creates a new read-only instance of KarakVault, bound to a specific deployed contract.
+func NewKarakVaultCaller(address common.Address, caller bind.ContractCaller) (*KarakVaultCaller, error) {
+	contract, err := bindKarakVault(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &KarakVaultCaller{contract: contract}, nil
+}
+
+// NewKarakVaultTransactor creates a new write-only instance of KarakVault, bound to a specific deployed contract.
+func NewKarakVaultTransactor(address common.Address, transactor bind.ContractTransactor) (*KarakVaultTransactor, error) {
+	contract, err := bindKarakVault(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &KarakVaultTransactor{contract: contract}, nil
+}
+
+// NewKarakVaultFilterer creates a new log filterer instance of KarakVault, bound to a specific deployed contract.
+func NewKarakVaultFilterer(address common.Address, filterer bind.ContractFilterer) (*KarakVaultFilterer, error) {
+	contract, err := bindKarakVault(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &KarakVaultFilterer{contract: contract}, nil
+}
+
+// bindKarakVault binds a generic wrapper to an already deployed contract.
+func bindKarakVault(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := KarakVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_KarakVault *KarakVaultRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _KarakVault.Contract.KarakVaultCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_KarakVault *KarakVaultRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _KarakVault.Contract.KarakVaultTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_KarakVault *KarakVaultRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _KarakVault.Contract.KarakVaultTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_KarakVault *KarakVaultCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _KarakVault.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_KarakVault *KarakVaultTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _KarakVault.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_KarakVault *KarakVaultTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _KarakVault.Contract.contract.Transact(opts, method, params...)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_KarakVault *KarakVaultCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_KarakVault *KarakVaultSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.Allowance(&_KarakVault.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_KarakVault *KarakVaultCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.Allowance(&_KarakVault.CallOpts, owner, spender)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_KarakVault *KarakVaultCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_KarakVault *KarakVaultSession) Asset() (common.Address, error) {
+	return _KarakVault.Contract.Asset(&_KarakVault.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_KarakVault *KarakVaultCallerSession) Asset() (common.Address, error) {
+	return _KarakVault.Contract.Asset(&_KarakVault.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_KarakVault *KarakVaultCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_KarakVault *KarakVaultSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.BalanceOf(&_KarakVault.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_KarakVault *KarakVaultCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.BalanceOf(&_KarakVault.CallOpts, account)
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256 assets)
+func (_KarakVault *KarakVaultCaller) ConvertToAssets(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "convertToAssets", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256 assets)
+func (_KarakVault *KarakVaultSession) ConvertToAssets(shares *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.ConvertToAssets(&_KarakVault.CallOpts, shares)
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256 assets)
+func (_KarakVault *KarakVaultCallerSession) ConvertToAssets(shares *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.ConvertToAssets(&_KarakVault.CallOpts, shares)
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256 shares)
+func (_KarakVault *KarakVaultCaller) ConvertToShares(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "convertToShares", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256 shares)
+func (_KarakVault *KarakVaultSession) ConvertToShares(assets *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.ConvertToShares(&_KarakVault.CallOpts, assets)
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256 shares)
+func (_KarakVault *KarakVaultCallerSession) ConvertToShares(assets *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.ConvertToShares(&_KarakVault.CallOpts, assets)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_KarakVault *KarakVaultCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_KarakVault *KarakVaultSession) Decimals() (uint8, error) {
+	return _KarakVault.Contract.Decimals(&_KarakVault.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_KarakVault *KarakVaultCallerSession) Decimals() (uint8, error) {
+	return _KarakVault.Contract.Decimals(&_KarakVault.CallOpts)
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address receiver) view returns(uint256 maxAssets)
+func (_KarakVault *KarakVaultCaller) MaxDeposit(opts *bind.CallOpts, receiver common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "maxDeposit", receiver)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address receiver) view returns(uint256 maxAssets)
+func (_KarakVault *KarakVaultSession) MaxDeposit(receiver common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.MaxDeposit(&_KarakVault.CallOpts, receiver)
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address receiver) view returns(uint256 maxAssets)
+func (_KarakVault *KarakVaultCallerSession) MaxDeposit(receiver common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.MaxDeposit(&_KarakVault.CallOpts, receiver)
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address receiver) view returns(uint256 maxShares)
+func (_KarakVault *KarakVaultCaller) MaxMint(opts *bind.CallOpts, receiver common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "maxMint", receiver)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address receiver) view returns(uint256 maxShares)
+func (_KarakVault *KarakVaultSession) MaxMint(receiver common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.MaxMint(&_KarakVault.CallOpts, receiver)
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address receiver) view returns(uint256 maxShares)
+func (_KarakVault *KarakVaultCallerSession) MaxMint(receiver common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.MaxMint(&_KarakVault.CallOpts, receiver)
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256 maxShares)
+func (_KarakVault *KarakVaultCaller) MaxRedeem(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "maxRedeem", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256 maxShares)
+func (_KarakVault *KarakVaultSession) MaxRedeem(owner common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.MaxRedeem(&_KarakVault.CallOpts, owner)
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256 maxShares)
+func (_KarakVault *KarakVaultCallerSession) MaxRedeem(owner common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.MaxRedeem(&_KarakVault.CallOpts, owner)
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256 maxAssets)
+func (_KarakVault *KarakVaultCaller) MaxWithdraw(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "maxWithdraw", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256 maxAssets)
+func (_KarakVault *KarakVaultSession) MaxWithdraw(owner common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.MaxWithdraw(&_KarakVault.CallOpts, owner)
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256 maxAssets)
+func (_KarakVault *KarakVaultCallerSession) MaxWithdraw(owner common.Address) (*big.Int, error) {
+	return _KarakVault.Contract.MaxWithdraw(&_KarakVault.CallOpts, owner)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_KarakVault *KarakVaultCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_KarakVault *KarakVaultSession) Name() (string, error) {
+	return _KarakVault.Contract.Name(&_KarakVault.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_KarakVault *KarakVaultCallerSession) Name() (string, error) {
+	return _KarakVault.Contract.Name(&_KarakVault.CallOpts)
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256 shares)
+func (_KarakVault *KarakVaultCaller) PreviewDeposit(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "previewDeposit", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256 shares)
+func (_KarakVault *KarakVaultSession) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.PreviewDeposit(&_KarakVault.CallOpts, assets)
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256 shares)
+func (_KarakVault *KarakVaultCallerSession) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.PreviewDeposit(&_KarakVault.CallOpts, assets)
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256 assets)
+func (_KarakVault *KarakVaultCaller) PreviewMint(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "previewMint", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256 assets)
+func (_KarakVault *KarakVaultSession) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.PreviewMint(&_KarakVault.CallOpts, shares)
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256 assets)
+func (_KarakVault *KarakVaultCallerSession) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.PreviewMint(&_KarakVault.CallOpts, shares)
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256 assets)
+func (_KarakVault *KarakVaultCaller) PreviewRedeem(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "previewRedeem", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256 assets)
+func (_KarakVault *KarakVaultSession) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.PreviewRedeem(&_KarakVault.CallOpts, shares)
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256 assets)
+func (_KarakVault *KarakVaultCallerSession) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.PreviewRedeem(&_KarakVault.CallOpts, shares)
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256 shares)
+func (_KarakVault *KarakVaultCaller) PreviewWithdraw(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "previewWithdraw", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256 shares)
+func (_KarakVault *KarakVaultSession) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.PreviewWithdraw(&_KarakVault.CallOpts, assets)
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256 shares)
+func (_KarakVault *KarakVaultCallerSession) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return _KarakVault.Contract.PreviewWithdraw(&_KarakVault.CallOpts, assets)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_KarakVault *KarakVaultCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_KarakVault *KarakVaultSession) Symbol() (string, error) {
+	return _KarakVault.Contract.Symbol(&_KarakVault.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_KarakVault *KarakVaultCallerSession) Symbol() (string, error) {
+	return _KarakVault.Contract.Symbol(&_KarakVault.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_KarakVault *KarakVaultCaller) TotalAssets(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "totalAssets")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_KarakVault *KarakVaultSession) TotalAssets() (*big.Int, error) {
+	return _KarakVault.Contract.TotalAssets(&_KarakVault.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_KarakVault *KarakVaultCallerSession) TotalAssets() (*big.Int, error) {
+	return _KarakVault.Contract.TotalAssets(&_KarakVault.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_KarakVault *KarakVaultCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _KarakVault.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_KarakVault *KarakVaultSession) TotalSupply() (*big.Int, error) {
+	return _KarakVault.Contract.TotalSupply(&_KarakVault.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_KarakVault *KarakVaultCallerSession) TotalSupply() (*big.Int, error) {
+	return _KarakVault.Contract.TotalSupply(&_KarakVault.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_KarakVault *KarakVaultTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _KarakVault.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_KarakVault *KarakVaultSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _KarakVault.Contract.Approve(&_KarakVault.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_KarakVault *KarakVaultTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _KarakVault.Contract.Approve(&_KarakVault.TransactOpts, spender, value)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256 shares)
+func (_KarakVault *KarakVaultTransactor) Deposit(opts *bind.TransactOpts, assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _KarakVault.contract.Transact(opts, "deposit", assets, receiver)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256 shares)
+func (_KarakVault *KarakVaultSession) Deposit(assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _KarakVault.Contract.Deposit(&_KarakVault.TransactOpts, assets, receiver)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256 shares)
+func (_KarakVault *KarakVaultTransactorSession) Deposit(assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _KarakVault.Contract.Deposit(&_KarakVault.TransactOpts, assets, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256 assets)
+func (_KarakVault *KarakVaultTransactor) Mint(opts *bind.TransactOpts, shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _KarakVault.contract.Transact(opts, "mint", shares, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256 assets)
+func (_KarakVault *KarakVaultSession) Mint(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _KarakVault.Contract.Mint(&_KarakVault.TransactOpts, shares, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256 assets)
+func (_KarakVault *KarakVaultTransactorSession) Mint(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _KarakVault.Contract.Mint(&_KarakVault.TransactOpts, shares, receiver)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256 assets)
+func (_KarakVault *KarakVaultTransactor) Redeem(opts *bind.TransactOpts, shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _KarakVault.contract.Transact(opts, "redeem", shares, receiver, owner)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256 assets)
+func (_KarakVault *KarakVaultSession) Redeem(shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _KarakVault.Contract.Redeem(&_KarakVault.TransactOpts, shares, receiver, owner)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256 assets)
+func (_KarakVault *KarakVaultTransactorSession) Redeem(shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _KarakVault.Contract.Redeem(&_KarakVault.TransactOpts, shares, receiver, owner)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_KarakVault *KarakVaultTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _KarakVault.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_KarakVault *KarakVaultSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _KarakVault.Contract.Transfer(&_KarakVault.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_KarakVault *KarakVaultTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _KarakVault.Contract.Transfer(&_KarakVault.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_KarakVault *KarakVaultTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _KarakVault.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_KarakVault *KarakVaultSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _KarakVault.Contract.TransferFrom(&_KarakVault.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_KarakVault *KarakVaultTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _KarakVault.Contract.TransferFrom(&_KarakVault.TransactOpts, from, to, value)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256 shares)
+func (_KarakVault *KarakVaultTransactor) Withdraw(opts *bind.TransactOpts, assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _KarakVault.contract.Transact(opts, "withdraw", assets, receiver, owner)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256 shares)
+func (_KarakVault *KarakVaultSession) Withdraw(assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _KarakVault.Contract.Withdraw(&_KarakVault.TransactOpts, assets, receiver, owner)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256 shares)
+func (_KarakVault *KarakVaultTransactorSession) Withdraw(assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _KarakVault.Contract.Withdraw(&_KarakVault.TransactOpts, assets, receiver, owner)
+}
+
+// KarakVaultApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the KarakVault contract.
+type KarakVaultApprovalIterator struct {
+	Event *KarakVaultApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *KarakVaultApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(KarakVaultApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(KarakVaultApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *KarakVaultApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *KarakVaultApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// KarakVaultApproval represents a Approval event raised by the KarakVault contract.
+type KarakVaultApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_KarakVault *KarakVaultFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*KarakVaultApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _KarakVault.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &KarakVaultApprovalIterator{contract: _KarakVault.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_KarakVault *KarakVaultFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *KarakVaultApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _KarakVault.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(KarakVaultApproval)
+				if err := _KarakVault.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_KarakVault *KarakVaultFilterer) ParseApproval(log types.Log) (*KarakVaultApproval, error) {
+	event := new(KarakVaultApproval)
+	if err := _KarakVault.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// KarakVaultDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the KarakVault contract.
+type KarakVaultDepositIterator struct {
+	Event *KarakVaultDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *KarakVaultDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(KarakVaultDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(KarakVaultDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *KarakVaultDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *KarakVaultDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// KarakVaultDeposit represents a Deposit event raised by the KarakVault contract.
+type KarakVaultDeposit struct {
+	Sender common.Address
+	Owner  common.Address
+	Assets *big.Int
+	Shares *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_KarakVault *KarakVaultFilterer) FilterDeposit(opts *bind.FilterOpts, sender []common.Address, owner []common.Address) (*KarakVaultDepositIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _KarakVault.contract.FilterLogs(opts, "Deposit", senderRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &KarakVaultDepositIterator{contract: _KarakVault.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_KarakVault *KarakVaultFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *KarakVaultDeposit, sender []common.Address, owner []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _KarakVault.contract.WatchLogs(opts, "Deposit", senderRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(KarakVaultDeposit)
+				if err := _KarakVault.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_KarakVault *KarakVaultFilterer) ParseDeposit(log types.Log) (*KarakVaultDeposit, error) {
+	event := new(KarakVaultDeposit)
+	if err := _KarakVault.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// KarakVaultTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the KarakVault contract.
+type KarakVaultTransferIterator struct {
+	Event *KarakVaultTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *KarakVaultTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(KarakVaultTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(KarakVaultTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *KarakVaultTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *KarakVaultTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// KarakVaultTransfer represents a Transfer event raised by the KarakVault contract.
+type KarakVaultTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_KarakVault *KarakVaultFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*KarakVaultTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _KarakVault.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &KarakVaultTransferIterator{contract: _KarakVault.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_KarakVault *KarakVaultFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *KarakVaultTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _KarakVault.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(KarakVaultTransfer)
+				if err := _KarakVault.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_KarakVault *KarakVaultFilterer) ParseTransfer(log types.Log) (*KarakVaultTransfer, error) {
+	event := new(KarakVaultTransfer)
+	if err := _KarakVault.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// KarakVaultWithdrawIterator is returned from FilterWithdraw and is used to iterate over the raw logs and unpacked data for Withdraw events raised by the KarakVault contract.
+type KarakVaultWithdrawIterator struct {
+	Event *KarakVaultWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *KarakVaultWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(KarakVaultWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(KarakVaultWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *KarakVaultWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *KarakVaultWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// KarakVaultWithdraw represents a Withdraw event raised by the KarakVault contract.
+type KarakVaultWithdraw struct {
+	Sender   common.Address
+	Receiver common.Address
+	Owner    common.Address
+	Assets   *big.Int
+	Shares   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdraw is a free log retrieval operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_KarakVault *KarakVaultFilterer) FilterWithdraw(opts *bind.FilterOpts, sender []common.Address, receiver []common.Address, owner []common.Address) (*KarakVaultWithdrawIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _KarakVault.contract.FilterLogs(opts, "Withdraw", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &KarakVaultWithdrawIterator{contract: _KarakVault.contract, event: "Withdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdraw is a free log subscription operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_KarakVault *KarakVaultFilterer) WatchWithdraw(opts *bind.WatchOpts, sink chan<- *KarakVaultWithdraw, sender []common.Address, receiver []common.Address, owner []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _KarakVault.contract.WatchLogs(opts, "Withdraw", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(KarakVaultWithdraw)
+				if err := _KarakVault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdraw is a log parse operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_KarakVault *KarakVaultFilterer) ParseWithdraw(log types.Log) (*KarakVaultWithdraw, error) {
+	event := new(KarakVaultWithdraw)
+	if err := _KarakVault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
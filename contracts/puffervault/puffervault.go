@@ -0,0 +1,1700 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package puffervault
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// PufferVaultMetaData contains all meta data concerning the PufferVault contract.
+var PufferVaultMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"contractIStETH\",\"name\":\"stETH\",\"type\":\"address\"},{\"internalType\":\"contractIWETH\",\"name\":\"weth\",\"type\":\"address\"},{\"internalType\":\"contractILidoWithdrawalQueue\",\"name\":\"lidoWithdrawalQueue\",\"type\":\"address\"},{\"internalType\":\"contractIStrategy\",\"name\":\"stETHStrategy\",\"type\":\"address\"},{\"internalType\":\"contractIEigenLayer\",\"name\":\"eigenStrategyManager\",\"type\":\"address\"},{\"internalType\":\"contractIPufferOracle\",\"name\":\"oracle\",\"type\":\"address\"},{\"internalType\":\"contractIDelegationManager\",\"name\":\"delegationManager\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"asset\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalAssets\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"name\":\"convertToShares\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"convertToAssets\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"maxDeposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"maxAssets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"maxMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"maxShares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"maxWithdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"maxAssets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"maxRedeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"maxShares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"name\":\"previewDeposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"previewMint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"name\":\"previewWithdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"previewRedeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"withdraw\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"redeem\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"assets\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"Withdraw\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"depositETH\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"stETHSharesAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"depositStETH\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getExitFeeBasisPoints\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getRemainingAssetsDailyWithdrawalLimit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"nonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DOMAIN_SEPARATOR\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"permit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// PufferVaultABI is the input ABI used to generate the binding from.
+// Deprecated: Use PufferVaultMetaData.ABI instead.
+var PufferVaultABI = PufferVaultMetaData.ABI
+
+// PufferVault is an auto generated Go binding around an Ethereum contract.
+type PufferVault struct {
+	PufferVaultCaller     // Read-only binding to the contract
+	PufferVaultTransactor // Write-only binding to the contract
+	PufferVaultFilterer   // Log filterer for contract events
+}
+
+// PufferVaultCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PufferVaultCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PufferVaultTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PufferVaultTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PufferVaultFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PufferVaultFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PufferVaultSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PufferVaultSession struct {
+	Contract     *PufferVault      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PufferVaultCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PufferVaultCallerSession struct {
+	Contract *PufferVaultCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// PufferVaultTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PufferVaultTransactorSession struct {
+	Contract     *PufferVaultTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// PufferVaultRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PufferVaultRaw struct {
+	Contract *PufferVault // Generic contract binding to access the raw methods on
+}
+
+// PufferVaultCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PufferVaultCallerRaw struct {
+	Contract *PufferVaultCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PufferVaultTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PufferVaultTransactorRaw struct {
+	Contract *PufferVaultTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPufferVault creates a new instance of PufferVault, bound to a specific deployed contract.
+func NewPufferVault(address common.Address, backend bind.ContractBackend) (*PufferVault, error) {
+	contract, err := bindPufferVault(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PufferVault{PufferVaultCaller: PufferVaultCaller{contract: contract}, PufferVaultTransactor: PufferVaultTransactor{contract: contract}, PufferVaultFilterer: PufferVaultFilterer{contract: contract}}, nil
+}
+
+// NewPufferVaultCaller creates a new read-only instance of PufferVault, bound to a specific deployed contract.
+func NewPufferVaultCaller(address common.Address, caller bind.ContractCaller) (*PufferVaultCaller, error) {
+	contract, err := bindPufferVault(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PufferVaultCaller{contract: contract}, nil
+}
+
+// NewPufferVaultTransactor creates a new write-only instance of PufferVault, bound to a specific deployed contract.
+func NewPufferVaultTransactor(address common.Address, transactor bind.ContractTransactor) (*PufferVaultTransactor, error) {
+	contract, err := bindPufferVault(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PufferVaultTransactor{contract: contract}, nil
+}
+
+// NewPufferVaultFilterer creates a new log filterer instance of PufferVault, bound to a specific deployed contract.
+func NewPufferVaultFilterer(address common.Address, filterer bind.ContractFilterer) (*PufferVaultFilterer, error) {
+	contract, err := bindPufferVault(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PufferVaultFilterer{contract: contract}, nil
+}
+
+// bindPufferVault binds a generic wrapper to an already deployed contract.
+func bindPufferVault(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := PufferVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PufferVault *PufferVaultRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PufferVault.Contract.PufferVaultCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PufferVault *PufferVaultRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PufferVault.Contract.PufferVaultTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PufferVault *PufferVaultRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PufferVault.Contract.PufferVaultTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PufferVault *PufferVaultCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PufferVault.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PufferVault *PufferVaultTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PufferVault.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PufferVault *PufferVaultTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PufferVault.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_PufferVault *PufferVaultCaller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_PufferVault *PufferVaultSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _PufferVault.Contract.DOMAINSEPARATOR(&_PufferVault.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_PufferVault *PufferVaultCallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _PufferVault.Contract.DOMAINSEPARATOR(&_PufferVault.CallOpts)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_PufferVault *PufferVaultCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_PufferVault *PufferVaultSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.Allowance(&_PufferVault.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_PufferVault *PufferVaultCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.Allowance(&_PufferVault.CallOpts, owner, spender)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_PufferVault *PufferVaultCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_PufferVault *PufferVaultSession) Asset() (common.Address, error) {
+	return _PufferVault.Contract.Asset(&_PufferVault.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_PufferVault *PufferVaultCallerSession) Asset() (common.Address, error) {
+	return _PufferVault.Contract.Asset(&_PufferVault.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_PufferVault *PufferVaultCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_PufferVault *PufferVaultSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.BalanceOf(&_PufferVault.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_PufferVault *PufferVaultCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.BalanceOf(&_PufferVault.CallOpts, account)
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256 assets)
+func (_PufferVault *PufferVaultCaller) ConvertToAssets(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "convertToAssets", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256 assets)
+func (_PufferVault *PufferVaultSession) ConvertToAssets(shares *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.ConvertToAssets(&_PufferVault.CallOpts, shares)
+}
+
+// ConvertToAssets is a free data retrieval call binding the contract method 0x07a2d13a.
+//
+// Solidity: function convertToAssets(uint256 shares) view returns(uint256 assets)
+func (_PufferVault *PufferVaultCallerSession) ConvertToAssets(shares *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.ConvertToAssets(&_PufferVault.CallOpts, shares)
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256 shares)
+func (_PufferVault *PufferVaultCaller) ConvertToShares(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "convertToShares", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256 shares)
+func (_PufferVault *PufferVaultSession) ConvertToShares(assets *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.ConvertToShares(&_PufferVault.CallOpts, assets)
+}
+
+// ConvertToShares is a free data retrieval call binding the contract method 0xc6e6f592.
+//
+// Solidity: function convertToShares(uint256 assets) view returns(uint256 shares)
+func (_PufferVault *PufferVaultCallerSession) ConvertToShares(assets *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.ConvertToShares(&_PufferVault.CallOpts, assets)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_PufferVault *PufferVaultCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_PufferVault *PufferVaultSession) Decimals() (uint8, error) {
+	return _PufferVault.Contract.Decimals(&_PufferVault.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_PufferVault *PufferVaultCallerSession) Decimals() (uint8, error) {
+	return _PufferVault.Contract.Decimals(&_PufferVault.CallOpts)
+}
+
+// GetExitFeeBasisPoints is a free data retrieval call binding the contract method 0x8fd356ff.
+//
+// Solidity: function getExitFeeBasisPoints() view returns(uint256)
+func (_PufferVault *PufferVaultCaller) GetExitFeeBasisPoints(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "getExitFeeBasisPoints")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetExitFeeBasisPoints is a free data retrieval call binding the contract method 0x8fd356ff.
+//
+// Solidity: function getExitFeeBasisPoints() view returns(uint256)
+func (_PufferVault *PufferVaultSession) GetExitFeeBasisPoints() (*big.Int, error) {
+	return _PufferVault.Contract.GetExitFeeBasisPoints(&_PufferVault.CallOpts)
+}
+
+// GetExitFeeBasisPoints is a free data retrieval call binding the contract method 0x8fd356ff.
+//
+// Solidity: function getExitFeeBasisPoints() view returns(uint256)
+func (_PufferVault *PufferVaultCallerSession) GetExitFeeBasisPoints() (*big.Int, error) {
+	return _PufferVault.Contract.GetExitFeeBasisPoints(&_PufferVault.CallOpts)
+}
+
+// GetRemainingAssetsDailyWithdrawalLimit is a free data retrieval call binding the contract method 0xaf24278c.
+//
+// Solidity: function getRemainingAssetsDailyWithdrawalLimit() view returns(uint256)
+func (_PufferVault *PufferVaultCaller) GetRemainingAssetsDailyWithdrawalLimit(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "getRemainingAssetsDailyWithdrawalLimit")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetRemainingAssetsDailyWithdrawalLimit is a free data retrieval call binding the contract method 0xaf24278c.
+//
+// Solidity: function getRemainingAssetsDailyWithdrawalLimit() view returns(uint256)
+func (_PufferVault *PufferVaultSession) GetRemainingAssetsDailyWithdrawalLimit() (*big.Int, error) {
+	return _PufferVault.Contract.GetRemainingAssetsDailyWithdrawalLimit(&_PufferVault.CallOpts)
+}
+
+// GetRemainingAssetsDailyWithdrawalLimit is a free data retrieval call binding the contract method 0xaf24278c.
+//
+// Solidity: function getRemainingAssetsDailyWithdrawalLimit() view returns(uint256)
+func (_PufferVault *PufferVaultCallerSession) GetRemainingAssetsDailyWithdrawalLimit() (*big.Int, error) {
+	return _PufferVault.Contract.GetRemainingAssetsDailyWithdrawalLimit(&_PufferVault.CallOpts)
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address receiver) view returns(uint256 maxAssets)
+func (_PufferVault *PufferVaultCaller) MaxDeposit(opts *bind.CallOpts, receiver common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "maxDeposit", receiver)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address receiver) view returns(uint256 maxAssets)
+func (_PufferVault *PufferVaultSession) MaxDeposit(receiver common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.MaxDeposit(&_PufferVault.CallOpts, receiver)
+}
+
+// MaxDeposit is a free data retrieval call binding the contract method 0x402d267d.
+//
+// Solidity: function maxDeposit(address receiver) view returns(uint256 maxAssets)
+func (_PufferVault *PufferVaultCallerSession) MaxDeposit(receiver common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.MaxDeposit(&_PufferVault.CallOpts, receiver)
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address receiver) view returns(uint256 maxShares)
+func (_PufferVault *PufferVaultCaller) MaxMint(opts *bind.CallOpts, receiver common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "maxMint", receiver)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address receiver) view returns(uint256 maxShares)
+func (_PufferVault *PufferVaultSession) MaxMint(receiver common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.MaxMint(&_PufferVault.CallOpts, receiver)
+}
+
+// MaxMint is a free data retrieval call binding the contract method 0xc63d75b6.
+//
+// Solidity: function maxMint(address receiver) view returns(uint256 maxShares)
+func (_PufferVault *PufferVaultCallerSession) MaxMint(receiver common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.MaxMint(&_PufferVault.CallOpts, receiver)
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256 maxShares)
+func (_PufferVault *PufferVaultCaller) MaxRedeem(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "maxRedeem", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256 maxShares)
+func (_PufferVault *PufferVaultSession) MaxRedeem(owner common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.MaxRedeem(&_PufferVault.CallOpts, owner)
+}
+
+// MaxRedeem is a free data retrieval call binding the contract method 0xd905777e.
+//
+// Solidity: function maxRedeem(address owner) view returns(uint256 maxShares)
+func (_PufferVault *PufferVaultCallerSession) MaxRedeem(owner common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.MaxRedeem(&_PufferVault.CallOpts, owner)
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256 maxAssets)
+func (_PufferVault *PufferVaultCaller) MaxWithdraw(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "maxWithdraw", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256 maxAssets)
+func (_PufferVault *PufferVaultSession) MaxWithdraw(owner common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.MaxWithdraw(&_PufferVault.CallOpts, owner)
+}
+
+// MaxWithdraw is a free data retrieval call binding the contract method 0xce96cb77.
+//
+// Solidity: function maxWithdraw(address owner) view returns(uint256 maxAssets)
+func (_PufferVault *PufferVaultCallerSession) MaxWithdraw(owner common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.MaxWithdraw(&_PufferVault.CallOpts, owner)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_PufferVault *PufferVaultCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_PufferVault *PufferVaultSession) Name() (string, error) {
+	return _PufferVault.Contract.Name(&_PufferVault.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_PufferVault *PufferVaultCallerSession) Name() (string, error) {
+	return _PufferVault.Contract.Name(&_PufferVault.CallOpts)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_PufferVault *PufferVaultCaller) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "nonces", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_PufferVault *PufferVaultSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.Nonces(&_PufferVault.CallOpts, owner)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_PufferVault *PufferVaultCallerSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _PufferVault.Contract.Nonces(&_PufferVault.CallOpts, owner)
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256 shares)
+func (_PufferVault *PufferVaultCaller) PreviewDeposit(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "previewDeposit", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256 shares)
+func (_PufferVault *PufferVaultSession) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.PreviewDeposit(&_PufferVault.CallOpts, assets)
+}
+
+// PreviewDeposit is a free data retrieval call binding the contract method 0xef8b30f7.
+//
+// Solidity: function previewDeposit(uint256 assets) view returns(uint256 shares)
+func (_PufferVault *PufferVaultCallerSession) PreviewDeposit(assets *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.PreviewDeposit(&_PufferVault.CallOpts, assets)
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256 assets)
+func (_PufferVault *PufferVaultCaller) PreviewMint(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "previewMint", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256 assets)
+func (_PufferVault *PufferVaultSession) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.PreviewMint(&_PufferVault.CallOpts, shares)
+}
+
+// PreviewMint is a free data retrieval call binding the contract method 0xb3d7f6b9.
+//
+// Solidity: function previewMint(uint256 shares) view returns(uint256 assets)
+func (_PufferVault *PufferVaultCallerSession) PreviewMint(shares *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.PreviewMint(&_PufferVault.CallOpts, shares)
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256 assets)
+func (_PufferVault *PufferVaultCaller) PreviewRedeem(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "previewRedeem", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256 assets)
+func (_PufferVault *PufferVaultSession) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.PreviewRedeem(&_PufferVault.CallOpts, shares)
+}
+
+// PreviewRedeem is a free data retrieval call binding the contract method 0x4cdad506.
+//
+// Solidity: function previewRedeem(uint256 shares) view returns(uint256 assets)
+func (_PufferVault *PufferVaultCallerSession) PreviewRedeem(shares *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.PreviewRedeem(&_PufferVault.CallOpts, shares)
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256 shares)
+func (_PufferVault *PufferVaultCaller) PreviewWithdraw(opts *bind.CallOpts, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "previewWithdraw", assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256 shares)
+func (_PufferVault *PufferVaultSession) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.PreviewWithdraw(&_PufferVault.CallOpts, assets)
+}
+
+// PreviewWithdraw is a free data retrieval call binding the contract method 0x0a28a477.
+//
+// Solidity: function previewWithdraw(uint256 assets) view returns(uint256 shares)
+func (_PufferVault *PufferVaultCallerSession) PreviewWithdraw(assets *big.Int) (*big.Int, error) {
+	return _PufferVault.Contract.PreviewWithdraw(&_PufferVault.CallOpts, assets)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_PufferVault *PufferVaultCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_PufferVault *PufferVaultSession) Symbol() (string, error) {
+	return _PufferVault.Contract.Symbol(&_PufferVault.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_PufferVault *PufferVaultCallerSession) Symbol() (string, error) {
+	return _PufferVault.Contract.Symbol(&_PufferVault.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_PufferVault *PufferVaultCaller) TotalAssets(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "totalAssets")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_PufferVault *PufferVaultSession) TotalAssets() (*big.Int, error) {
+	return _PufferVault.Contract.TotalAssets(&_PufferVault.CallOpts)
+}
+
+// TotalAssets is a free data retrieval call binding the contract method 0x01e1d114.
+//
+// Solidity: function totalAssets() view returns(uint256)
+func (_PufferVault *PufferVaultCallerSession) TotalAssets() (*big.Int, error) {
+	return _PufferVault.Contract.TotalAssets(&_PufferVault.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_PufferVault *PufferVaultCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PufferVault.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_PufferVault *PufferVaultSession) TotalSupply() (*big.Int, error) {
+	return _PufferVault.Contract.TotalSupply(&_PufferVault.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_PufferVault *PufferVaultCallerSession) TotalSupply() (*big.Int, error) {
+	return _PufferVault.Contract.TotalSupply(&_PufferVault.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_PufferVault *PufferVaultTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_PufferVault *PufferVaultSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _PufferVault.Contract.Approve(&_PufferVault.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_PufferVault *PufferVaultTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _PufferVault.Contract.Approve(&_PufferVault.TransactOpts, spender, value)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256 shares)
+func (_PufferVault *PufferVaultTransactor) Deposit(opts *bind.TransactOpts, assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "deposit", assets, receiver)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256 shares)
+func (_PufferVault *PufferVaultSession) Deposit(assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.Deposit(&_PufferVault.TransactOpts, assets, receiver)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x6e553f65.
+//
+// Solidity: function deposit(uint256 assets, address receiver) returns(uint256 shares)
+func (_PufferVault *PufferVaultTransactorSession) Deposit(assets *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.Deposit(&_PufferVault.TransactOpts, assets, receiver)
+}
+
+// DepositETH is a paid mutator transaction binding the contract method 0x2d2da806.
+//
+// Solidity: function depositETH(address receiver) payable returns(uint256)
+func (_PufferVault *PufferVaultTransactor) DepositETH(opts *bind.TransactOpts, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "depositETH", receiver)
+}
+
+// DepositETH is a paid mutator transaction binding the contract method 0x2d2da806.
+//
+// Solidity: function depositETH(address receiver) payable returns(uint256)
+func (_PufferVault *PufferVaultSession) DepositETH(receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.DepositETH(&_PufferVault.TransactOpts, receiver)
+}
+
+// DepositETH is a paid mutator transaction binding the contract method 0x2d2da806.
+//
+// Solidity: function depositETH(address receiver) payable returns(uint256)
+func (_PufferVault *PufferVaultTransactorSession) DepositETH(receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.DepositETH(&_PufferVault.TransactOpts, receiver)
+}
+
+// DepositStETH is a paid mutator transaction binding the contract method 0xf6dbd16f.
+//
+// Solidity: function depositStETH(uint256 stETHSharesAmount, address receiver) returns(uint256)
+func (_PufferVault *PufferVaultTransactor) DepositStETH(opts *bind.TransactOpts, stETHSharesAmount *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "depositStETH", stETHSharesAmount, receiver)
+}
+
+// DepositStETH is a paid mutator transaction binding the contract method 0xf6dbd16f.
+//
+// Solidity: function depositStETH(uint256 stETHSharesAmount, address receiver) returns(uint256)
+func (_PufferVault *PufferVaultSession) DepositStETH(stETHSharesAmount *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.DepositStETH(&_PufferVault.TransactOpts, stETHSharesAmount, receiver)
+}
+
+// DepositStETH is a paid mutator transaction binding the contract method 0xf6dbd16f.
+//
+// Solidity: function depositStETH(uint256 stETHSharesAmount, address receiver) returns(uint256)
+func (_PufferVault *PufferVaultTransactorSession) DepositStETH(stETHSharesAmount *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.DepositStETH(&_PufferVault.TransactOpts, stETHSharesAmount, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256 assets)
+func (_PufferVault *PufferVaultTransactor) Mint(opts *bind.TransactOpts, shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "mint", shares, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256 assets)
+func (_PufferVault *PufferVaultSession) Mint(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.Mint(&_PufferVault.TransactOpts, shares, receiver)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x94bf804d.
+//
+// Solidity: function mint(uint256 shares, address receiver) returns(uint256 assets)
+func (_PufferVault *PufferVaultTransactorSession) Mint(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.Mint(&_PufferVault.TransactOpts, shares, receiver)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_PufferVault *PufferVaultTransactor) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "permit", owner, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_PufferVault *PufferVaultSession) Permit(owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _PufferVault.Contract.Permit(&_PufferVault.TransactOpts, owner, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_PufferVault *PufferVaultTransactorSession) Permit(owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _PufferVault.Contract.Permit(&_PufferVault.TransactOpts, owner, spender, value, deadline, v, r, s)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256 assets)
+func (_PufferVault *PufferVaultTransactor) Redeem(opts *bind.TransactOpts, shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "redeem", shares, receiver, owner)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256 assets)
+func (_PufferVault *PufferVaultSession) Redeem(shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.Redeem(&_PufferVault.TransactOpts, shares, receiver, owner)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0xba087652.
+//
+// Solidity: function redeem(uint256 shares, address receiver, address owner) returns(uint256 assets)
+func (_PufferVault *PufferVaultTransactorSession) Redeem(shares *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.Redeem(&_PufferVault.TransactOpts, shares, receiver, owner)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_PufferVault *PufferVaultTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_PufferVault *PufferVaultSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _PufferVault.Contract.Transfer(&_PufferVault.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_PufferVault *PufferVaultTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _PufferVault.Contract.Transfer(&_PufferVault.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_PufferVault *PufferVaultTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_PufferVault *PufferVaultSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _PufferVault.Contract.TransferFrom(&_PufferVault.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_PufferVault *PufferVaultTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _PufferVault.Contract.TransferFrom(&_PufferVault.TransactOpts, from, to, value)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256 shares)
+func (_PufferVault *PufferVaultTransactor) Withdraw(opts *bind.TransactOpts, assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _PufferVault.contract.Transact(opts, "withdraw", assets, receiver, owner)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256 shares)
+func (_PufferVault *PufferVaultSession) Withdraw(assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.Withdraw(&_PufferVault.TransactOpts, assets, receiver, owner)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xb460af94.
+//
+// Solidity: function withdraw(uint256 assets, address receiver, address owner) returns(uint256 shares)
+func (_PufferVault *PufferVaultTransactorSession) Withdraw(assets *big.Int, receiver common.Address, owner common.Address) (*types.Transaction, error) {
+	return _PufferVault.Contract.Withdraw(&_PufferVault.TransactOpts, assets, receiver, owner)
+}
+
+// PufferVaultApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the PufferVault contract.
+type PufferVaultApprovalIterator struct {
+	Event *PufferVaultApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PufferVaultApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PufferVaultApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PufferVaultApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PufferVaultApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PufferVaultApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PufferVaultApproval represents a Approval event raised by the PufferVault contract.
+type PufferVaultApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_PufferVault *PufferVaultFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*PufferVaultApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _PufferVault.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PufferVaultApprovalIterator{contract: _PufferVault.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_PufferVault *PufferVaultFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *PufferVaultApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _PufferVault.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PufferVaultApproval)
+				if err := _PufferVault.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_PufferVault *PufferVaultFilterer) ParseApproval(log types.Log) (*PufferVaultApproval, error) {
+	event := new(PufferVaultApproval)
+	if err := _PufferVault.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PufferVaultDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the PufferVault contract.
+type PufferVaultDepositIterator struct {
+	Event *PufferVaultDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PufferVaultDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PufferVaultDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PufferVaultDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PufferVaultDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PufferVaultDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PufferVaultDeposit represents a Deposit event raised by the PufferVault contract.
+type PufferVaultDeposit struct {
+	Sender common.Address
+	Owner  common.Address
+	Assets *big.Int
+	Shares *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_PufferVault *PufferVaultFilterer) FilterDeposit(opts *bind.FilterOpts, sender []common.Address, owner []common.Address) (*PufferVaultDepositIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _PufferVault.contract.FilterLogs(opts, "Deposit", senderRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PufferVaultDepositIterator{contract: _PufferVault.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_PufferVault *PufferVaultFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *PufferVaultDeposit, sender []common.Address, owner []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _PufferVault.contract.WatchLogs(opts, "Deposit", senderRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PufferVaultDeposit)
+				if err := _PufferVault.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed sender, address indexed owner, uint256 assets, uint256 shares)
+func (_PufferVault *PufferVaultFilterer) ParseDeposit(log types.Log) (*PufferVaultDeposit, error) {
+	event := new(PufferVaultDeposit)
+	if err := _PufferVault.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PufferVaultTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the PufferVault contract.
+type PufferVaultTransferIterator struct {
+	Event *PufferVaultTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PufferVaultTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PufferVaultTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PufferVaultTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PufferVaultTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PufferVaultTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PufferVaultTransfer represents a Transfer event raised by the PufferVault contract.
+type PufferVaultTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_PufferVault *PufferVaultFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*PufferVaultTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _PufferVault.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PufferVaultTransferIterator{contract: _PufferVault.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_PufferVault *PufferVaultFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *PufferVaultTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _PufferVault.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PufferVaultTransfer)
+				if err := _PufferVault.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_PufferVault *PufferVaultFilterer) ParseTransfer(log types.Log) (*PufferVaultTransfer, error) {
+	event := new(PufferVaultTransfer)
+	if err := _PufferVault.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PufferVaultWithdrawIterator is returned from FilterWithdraw and is used to iterate over the raw logs and unpacked data for Withdraw events raised by the PufferVault contract.
+type PufferVaultWithdrawIterator struct {
+	Event *PufferVaultWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PufferVaultWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PufferVaultWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PufferVaultWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PufferVaultWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PufferVaultWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PufferVaultWithdraw represents a Withdraw event raised by the PufferVault contract.
+type PufferVaultWithdraw struct {
+	Sender   common.Address
+	Receiver common.Address
+	Owner    common.Address
+	Assets   *big.Int
+	Shares   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdraw is a free log retrieval operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_PufferVault *PufferVaultFilterer) FilterWithdraw(opts *bind.FilterOpts, sender []common.Address, receiver []common.Address, owner []common.Address) (*PufferVaultWithdrawIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _PufferVault.contract.FilterLogs(opts, "Withdraw", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PufferVaultWithdrawIterator{contract: _PufferVault.contract, event: "Withdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdraw is a free log subscription operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_PufferVault *PufferVaultFilterer) WatchWithdraw(opts *bind.WatchOpts, sink chan<- *PufferVaultWithdraw, sender []common.Address, receiver []common.Address, owner []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _PufferVault.contract.WatchLogs(opts, "Withdraw", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PufferVaultWithdraw)
+				if err := _PufferVault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdraw is a log parse operation binding the contract event 0xfbde797d201c681b91056529119e0b02407c7bb96a4a2c75c01fc9667232c8db.
+//
+// Solidity: event Withdraw(address indexed sender, address indexed receiver, address indexed owner, uint256 assets, uint256 shares)
+func (_PufferVault *PufferVaultFilterer) ParseWithdraw(log types.Log) (*PufferVaultWithdraw, error) {
+	event := new(PufferVaultWithdraw)
+	if err := _PufferVault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,6 @@
+//go:build tools
+
+package contracts
+
+// abigen is run by go generate, importing it here keeps its dependencies in go.sum
+import _ "github.com/ethereum/go-ethereum/cmd/abigen"
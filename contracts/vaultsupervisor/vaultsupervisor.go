@@ -0,0 +1,373 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package vaultsupervisor
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// WithdrawQueuedWithdrawal is an auto generated low-level Go binding around an user-defined struct.
+type WithdrawQueuedWithdrawal struct {
+	Staker      common.Address
+	DelegatedTo common.Address
+	Nonce       *big.Int
+	Start       *big.Int
+	Request     WithdrawWithdrawRequest
+}
+
+// WithdrawWithdrawRequest is an auto generated low-level Go binding around an user-defined struct.
+type WithdrawWithdrawRequest struct {
+	Vaults     []common.Address
+	Shares     []*big.Int
+	Withdrawer common.Address
+}
+
+// VaultSupervisorMetaData contains all meta data concerning the VaultSupervisor contract.
+var VaultSupervisorMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"contractIVault\",\"name\":\"vault\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minSharesOut\",\"type\":\"uint256\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIVault\",\"name\":\"vault\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minSharesOut\",\"type\":\"uint256\"}],\"name\":\"depositAndGimmie\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIVault\",\"name\":\"vault\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"gimmieShares\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIVault\",\"name\":\"vault\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"returnShares\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"}],\"name\":\"getDeposits\",\"outputs\":[{\"internalType\":\"contractIVault[]\",\"name\":\"vaults\",\"type\":\"address[]\"},{\"internalType\":\"contractIERC20[]\",\"name\":\"tokens\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"assets\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"contractIVault[]\",\"name\":\"vaults\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"shares\",\"type\":\"uint256[]\"},{\"internalType\":\"address\",\"name\":\"withdrawer\",\"type\":\"address\"}],\"internalType\":\"structWithdraw.WithdrawRequest[]\",\"name\":\"withdrawalRequest\",\"type\":\"tuple[]\"}],\"name\":\"startWithdraw\",\"outputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"withdrawalRoots\",\"type\":\"bytes32[]\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"delegatedTo\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"start\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"contractIVault[]\",\"name\":\"vaults\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"shares\",\"type\":\"uint256[]\"},{\"internalType\":\"address\",\"name\":\"withdrawer\",\"type\":\"address\"}],\"internalType\":\"structWithdraw.WithdrawRequest\",\"name\":\"request\",\"type\":\"tuple\"}],\"internalType\":\"structWithdraw.QueuedWithdrawal[]\",\"name\":\"withdrawConfigs\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"delegatedTo\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"start\",\"type\":\"uint256\"},{\"components\":[{\"internalType\":\"contractIVault[]\",\"name\":\"vaults\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"shares\",\"type\":\"uint256[]\"},{\"internalType\":\"address\",\"name\":\"withdrawer\",\"type\":\"address\"}],\"internalType\":\"structWithdraw.WithdrawRequest\",\"name\":\"request\",\"type\":\"tuple\"}],\"internalType\":\"structWithdraw.QueuedWithdrawal[]\",\"name\":\"startedWithdrawals\",\"type\":\"tuple[]\"}],\"name\":\"finishWithdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// VaultSupervisorABI is the input ABI used to generate the binding from.
+// Deprecated: Use VaultSupervisorMetaData.ABI instead.
+var VaultSupervisorABI = VaultSupervisorMetaData.ABI
+
+// VaultSupervisor is an auto generated Go binding around an Ethereum contract.
+type VaultSupervisor struct {
+	VaultSupervisorCaller     // Read-only binding to the contract
+	VaultSupervisorTransactor // Write-only binding to the contract
+	VaultSupervisorFilterer   // Log filterer for contract events
+}
+
+// VaultSupervisorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VaultSupervisorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VaultSupervisorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VaultSupervisorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VaultSupervisorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VaultSupervisorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VaultSupervisorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VaultSupervisorSession struct {
+	Contract     *VaultSupervisor  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VaultSupervisorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VaultSupervisorCallerSession struct {
+	Contract *VaultSupervisorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// VaultSupervisorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VaultSupervisorTransactorSession struct {
+	Contract     *VaultSupervisorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// VaultSupervisorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VaultSupervisorRaw struct {
+	Contract *VaultSupervisor // Generic contract binding to access the raw methods on
+}
+
+// VaultSupervisorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VaultSupervisorCallerRaw struct {
+	Contract *VaultSupervisorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VaultSupervisorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VaultSupervisorTransactorRaw struct {
+	Contract *VaultSupervisorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVaultSupervisor creates a new instance of VaultSupervisor, bound to a specific deployed contract.
+func NewVaultSupervisor(address common.Address, backend bind.ContractBackend) (*VaultSupervisor, error) {
+	contract, err := bindVaultSupervisor(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultSupervisor{VaultSupervisorCaller: VaultSupervisorCaller{contract: contract}, VaultSupervisorTransactor: VaultSupervisorTransactor{contract: contract}, VaultSupervisorFilterer: VaultSupervisorFilterer{contract: contract}}, nil
+}
+
+// NewVaultSupervisorCaller creates a new read-only instance of VaultSupervisor, bound to a specific deployed contract.
+func NewVaultSupervisorCaller(address common.Address, caller bind.ContractCaller) (*VaultSupervisorCaller, error) {
+	contract, err := bindVaultSupervisor(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultSupervisorCaller{contract: contract}, nil
+}
+
+// NewVaultSupervisorTransactor creates a new write-only instance of VaultSupervisor, bound to a specific deployed contract.
+func NewVaultSupervisorTransactor(address common.Address, transactor bind.ContractTransactor) (*VaultSupervisorTransactor, error) {
+	contract, err := bindVaultSupervisor(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultSupervisorTransactor{contract: contract}, nil
+}
+
+// NewVaultSupervisorFilterer creates a new log filterer instance of VaultSupervisor, bound to a specific deployed contract.
+func NewVaultSupervisorFilterer(address common.Address, filterer bind.ContractFilterer) (*VaultSupervisorFilterer, error) {
+	contract, err := bindVaultSupervisor(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultSupervisorFilterer{contract: contract}, nil
+}
+
+// bindVaultSupervisor binds a generic wrapper to an already deployed contract.
+func bindVaultSupervisor(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VaultSupervisorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VaultSupervisor *VaultSupervisorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VaultSupervisor.Contract.VaultSupervisorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VaultSupervisor *VaultSupervisorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.VaultSupervisorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VaultSupervisor *VaultSupervisorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.VaultSupervisorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_VaultSupervisor *VaultSupervisorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _VaultSupervisor.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_VaultSupervisor *VaultSupervisorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_VaultSupervisor *VaultSupervisorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetDeposits is a free data retrieval call binding the contract method 0x94f649dd.
+//
+// Solidity: function getDeposits(address staker) view returns(address[] vaults, address[] tokens, uint256[] assets)
+func (_VaultSupervisor *VaultSupervisorCaller) GetDeposits(opts *bind.CallOpts, staker common.Address) (struct {
+	Vaults []common.Address
+	Tokens []common.Address
+	Assets []*big.Int
+}, error) {
+	var out []interface{}
+	err := _VaultSupervisor.contract.Call(opts, &out, "getDeposits", staker)
+
+	outstruct := new(struct {
+		Vaults []common.Address
+		Tokens []common.Address
+		Assets []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Vaults = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Tokens = *abi.ConvertType(out[1], new([]common.Address)).(*[]common.Address)
+	outstruct.Assets = *abi.ConvertType(out[2], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetDeposits is a free data retrieval call binding the contract method 0x94f649dd.
+//
+// Solidity: function getDeposits(address staker) view returns(address[] vaults, address[] tokens, uint256[] assets)
+func (_VaultSupervisor *VaultSupervisorSession) GetDeposits(staker common.Address) (struct {
+	Vaults []common.Address
+	Tokens []common.Address
+	Assets []*big.Int
+}, error) {
+	return _VaultSupervisor.Contract.GetDeposits(&_VaultSupervisor.CallOpts, staker)
+}
+
+// GetDeposits is a free data retrieval call binding the contract method 0x94f649dd.
+//
+// Solidity: function getDeposits(address staker) view returns(address[] vaults, address[] tokens, uint256[] assets)
+func (_VaultSupervisor *VaultSupervisorCallerSession) GetDeposits(staker common.Address) (struct {
+	Vaults []common.Address
+	Tokens []common.Address
+	Assets []*big.Int
+}, error) {
+	return _VaultSupervisor.Contract.GetDeposits(&_VaultSupervisor.CallOpts, staker)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x0efe6a8b.
+//
+// Solidity: function deposit(address vault, uint256 amount, uint256 minSharesOut) returns(uint256 shares)
+func (_VaultSupervisor *VaultSupervisorTransactor) Deposit(opts *bind.TransactOpts, vault common.Address, amount *big.Int, minSharesOut *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.contract.Transact(opts, "deposit", vault, amount, minSharesOut)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x0efe6a8b.
+//
+// Solidity: function deposit(address vault, uint256 amount, uint256 minSharesOut) returns(uint256 shares)
+func (_VaultSupervisor *VaultSupervisorSession) Deposit(vault common.Address, amount *big.Int, minSharesOut *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.Deposit(&_VaultSupervisor.TransactOpts, vault, amount, minSharesOut)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x0efe6a8b.
+//
+// Solidity: function deposit(address vault, uint256 amount, uint256 minSharesOut) returns(uint256 shares)
+func (_VaultSupervisor *VaultSupervisorTransactorSession) Deposit(vault common.Address, amount *big.Int, minSharesOut *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.Deposit(&_VaultSupervisor.TransactOpts, vault, amount, minSharesOut)
+}
+
+// DepositAndGimmie is a paid mutator transaction binding the contract method 0x17070f02.
+//
+// Solidity: function depositAndGimmie(address vault, uint256 amount, uint256 minSharesOut) returns(uint256 shares)
+func (_VaultSupervisor *VaultSupervisorTransactor) DepositAndGimmie(opts *bind.TransactOpts, vault common.Address, amount *big.Int, minSharesOut *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.contract.Transact(opts, "depositAndGimmie", vault, amount, minSharesOut)
+}
+
+// DepositAndGimmie is a paid mutator transaction binding the contract method 0x17070f02.
+//
+// Solidity: function depositAndGimmie(address vault, uint256 amount, uint256 minSharesOut) returns(uint256 shares)
+func (_VaultSupervisor *VaultSupervisorSession) DepositAndGimmie(vault common.Address, amount *big.Int, minSharesOut *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.DepositAndGimmie(&_VaultSupervisor.TransactOpts, vault, amount, minSharesOut)
+}
+
+// DepositAndGimmie is a paid mutator transaction binding the contract method 0x17070f02.
+//
+// Solidity: function depositAndGimmie(address vault, uint256 amount, uint256 minSharesOut) returns(uint256 shares)
+func (_VaultSupervisor *VaultSupervisorTransactorSession) DepositAndGimmie(vault common.Address, amount *big.Int, minSharesOut *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.DepositAndGimmie(&_VaultSupervisor.TransactOpts, vault, amount, minSharesOut)
+}
+
+// FinishWithdraw is a paid mutator transaction binding the contract method 0x86e9a1f7.
+//
+// Solidity: function finishWithdraw((address,address,uint256,uint256,(address[],uint256[],address))[] startedWithdrawals) returns()
+func (_VaultSupervisor *VaultSupervisorTransactor) FinishWithdraw(opts *bind.TransactOpts, startedWithdrawals []WithdrawQueuedWithdrawal) (*types.Transaction, error) {
+	return _VaultSupervisor.contract.Transact(opts, "finishWithdraw", startedWithdrawals)
+}
+
+// FinishWithdraw is a paid mutator transaction binding the contract method 0x86e9a1f7.
+//
+// Solidity: function finishWithdraw((address,address,uint256,uint256,(address[],uint256[],address))[] startedWithdrawals) returns()
+func (_VaultSupervisor *VaultSupervisorSession) FinishWithdraw(startedWithdrawals []WithdrawQueuedWithdrawal) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.FinishWithdraw(&_VaultSupervisor.TransactOpts, startedWithdrawals)
+}
+
+// FinishWithdraw is a paid mutator transaction binding the contract method 0x86e9a1f7.
+//
+// Solidity: function finishWithdraw((address,address,uint256,uint256,(address[],uint256[],address))[] startedWithdrawals) returns()
+func (_VaultSupervisor *VaultSupervisorTransactorSession) FinishWithdraw(startedWithdrawals []WithdrawQueuedWithdrawal) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.FinishWithdraw(&_VaultSupervisor.TransactOpts, startedWithdrawals)
+}
+
+// GimmieShares is a paid mutator transaction binding the contract method 0x7fb2a0a1.
+//
+// Solidity: function gimmieShares(address vault, uint256 shares) returns()
+func (_VaultSupervisor *VaultSupervisorTransactor) GimmieShares(opts *bind.TransactOpts, vault common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.contract.Transact(opts, "gimmieShares", vault, shares)
+}
+
+// GimmieShares is a paid mutator transaction binding the contract method 0x7fb2a0a1.
+//
+// Solidity: function gimmieShares(address vault, uint256 shares) returns()
+func (_VaultSupervisor *VaultSupervisorSession) GimmieShares(vault common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.GimmieShares(&_VaultSupervisor.TransactOpts, vault, shares)
+}
+
+// GimmieShares is a paid mutator transaction binding the contract method 0x7fb2a0a1.
+//
+// Solidity: function gimmieShares(address vault, uint256 shares) returns()
+func (_VaultSupervisor *VaultSupervisorTransactorSession) GimmieShares(vault common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.GimmieShares(&_VaultSupervisor.TransactOpts, vault, shares)
+}
+
+// ReturnShares is a paid mutator transaction binding the contract method 0x8b198025.
+//
+// Solidity: function returnShares(address vault, uint256 shares) returns()
+func (_VaultSupervisor *VaultSupervisorTransactor) ReturnShares(opts *bind.TransactOpts, vault common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.contract.Transact(opts, "returnShares", vault, shares)
+}
+
+// ReturnShares is a paid mutator transaction binding the contract method 0x8b198025.
+//
+// Solidity: function returnShares(address vault, uint256 shares) returns()
+func (_VaultSupervisor *VaultSupervisorSession) ReturnShares(vault common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.ReturnShares(&_VaultSupervisor.TransactOpts, vault, shares)
+}
+
+// ReturnShares is a paid mutator transaction binding the contract method 0x8b198025.
+//
+// Solidity: function returnShares(address vault, uint256 shares) returns()
+func (_VaultSupervisor *VaultSupervisorTransactorSession) ReturnShares(vault common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.ReturnShares(&_VaultSupervisor.TransactOpts, vault, shares)
+}
+
+// StartWithdraw is a paid mutator transaction binding the contract method 0x92dca407.
+//
+// Solidity: function startWithdraw((address[],uint256[],address)[] withdrawalRequest) returns(bytes32[] withdrawalRoots, (address,address,uint256,uint256,(address[],uint256[],address))[] withdrawConfigs)
+func (_VaultSupervisor *VaultSupervisorTransactor) StartWithdraw(opts *bind.TransactOpts, withdrawalRequest []WithdrawWithdrawRequest) (*types.Transaction, error) {
+	return _VaultSupervisor.contract.Transact(opts, "startWithdraw", withdrawalRequest)
+}
+
+// StartWithdraw is a paid mutator transaction binding the contract method 0x92dca407.
+//
+// Solidity: function startWithdraw((address[],uint256[],address)[] withdrawalRequest) returns(bytes32[] withdrawalRoots, (address,address,uint256,uint256,(address[],uint256[],address))[] withdrawConfigs)
+func (_VaultSupervisor *VaultSupervisorSession) StartWithdraw(withdrawalRequest []WithdrawWithdrawRequest) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.StartWithdraw(&_VaultSupervisor.TransactOpts, withdrawalRequest)
+}
+
+// StartWithdraw is a paid mutator transaction binding the contract method 0x92dca407.
+//
+// Solidity: function startWithdraw((address[],uint256[],address)[] withdrawalRequest) returns(bytes32[] withdrawalRoots, (address,address,uint256,uint256,(address[],uint256[],address))[] withdrawConfigs)
+func (_VaultSupervisor *VaultSupervisorTransactorSession) StartWithdraw(withdrawalRequest []WithdrawWithdrawRequest) (*types.Transaction, error) {
+	return _VaultSupervisor.Contract.StartWithdraw(&_VaultSupervisor.TransactOpts, withdrawalRequest)
+}
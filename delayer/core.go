@@ -4,19 +4,47 @@ import (
 	"github.com/fatih/color"
 	"math/rand"
 	"puffDep/config"
+	"puffDep/metrics"
 	"time"
 )
 
 var warningText = color.New(color.FgYellow)
 
-func DelayBlock(config *config.Config) {
-	blockDelay := rand.Intn(config.Ethereum.Delays.Block.Max-config.Ethereum.Delays.Block.Min) + config.Ethereum.Delays.Block.Min
+// randomBetween returns a random value in [min, max], both ends included like config.Range, or
+// min when max is not above it
+func randomBetween(min int, max int) int {
+	if max <= min {
+		return min
+	}
+	return rand.Intn(max-min+1) + min
+}
+
+func DelayBlock(holder *config.Holder) {
+	config := holder.Get()
+	blockDelay := randomBetween(config.Ethereum.Delays.Block.Min, config.Ethereum.Delays.Block.Max)
 	warningText.Printf("[Block] Waiting for %d seconds\n", blockDelay)
 	time.Sleep(time.Duration(blockDelay) * time.Second)
+	metrics.DelaySecondsTotal.WithLabelValues("block").Add(float64(blockDelay))
 }
 
-func DelayWallet(config *config.Config) {
-	walletDelay := rand.Intn(config.Ethereum.Delays.Wallet.Max-config.Ethereum.Delays.Wallet.Min) + config.Ethereum.Delays.Wallet.Min
+func DelayWallet(holder *config.Holder) {
+	config := holder.Get()
+	walletDelay := randomBetween(config.Ethereum.Delays.Wallet.Min, config.Ethereum.Delays.Wallet.Max)
 	warningText.Printf("[Wallet] Waiting for %d seconds\n", walletDelay)
 	time.Sleep(time.Duration(walletDelay) * time.Second)
+	metrics.DelaySecondsTotal.WithLabelValues("wallet").Add(float64(walletDelay))
+}
+
+// WaitWhilePaused blocks for as long as the config has the runner paused
+func WaitWhilePaused(holder *config.Holder) {
+	if !holder.Get().Ethereum.Workflow.Paused {
+		return
+	}
+	warningText.Printf("[Pause] Runner is paused, waiting for ethereum.workflow.paused to be turned off\n")
+	start := time.Now()
+	for holder.Get().Ethereum.Workflow.Paused {
+		time.Sleep(5 * time.Second)
+	}
+	metrics.DelaySecondsTotal.WithLabelValues("pause").Add(time.Since(start).Seconds())
+	warningText.Printf("[Pause] Resuming after %s\n", time.Since(start).Round(time.Second))
 }
@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/ethereum/go-ethereum/ethclient"
-	"log"
 	"math/big"
 	"puffDep/config"
+	"puffDep/metrics"
 	"time"
 )
 
@@ -42,23 +42,36 @@ func CalculateSlippage(valueInWei *big.Int) *big.Int {
 	return minValue
 }
 
-func CheckGasPrice(client *ethclient.Client, cfg *config.Config) {
-	limit := big.NewInt(int64(cfg.Ethereum.Workflow.GweiLimit))
+// GasWaitHook, when set, is called by CheckGasPrice after every wait with the total time waited so far
+var GasWaitHook func(waited time.Duration, gasPriceGwei string)
+
+// CheckGasPrice blocks until the gas price is within the configured limit. The limit is read on
+// every check, so a reloaded gweiLimit applies to a wait already in progress. It returns an error
+// when the client can't tell the gas price, e.g. a wallet's proxy went down.
+func CheckGasPrice(client *ethclient.Client, cfg *config.Holder) error {
+	start := time.Now()
 	for {
+		limit := big.NewInt(int64(cfg.Get().Ethereum.Workflow.GweiLimit))
 		gasPrice, err := client.SuggestGasPrice(context.Background())
 		if err != nil {
-			log.Fatalf("Failed to get gas price: %v", err)
+			return fmt.Errorf("failed to get gas price: %v", err)
 		}
 
 		gasPriceGwei := new(big.Int).Div(gasPrice, big.NewInt(1e9))
+		gweiFloat, _ := ConvertWeiToGwei(gasPrice).Float64()
+		metrics.GasPriceGwei.Set(gweiFloat)
 		fmt.Printf("Current gas price: %s Gwei\n", gasPriceGwei.String())
 
 		if gasPriceGwei.Cmp(limit) <= 0 {
 			fmt.Println("Gas price is within the limit, proceeding...")
-			break
+			return nil
 		}
 
 		fmt.Println("Gas price is too high, waiting...")
 		time.Sleep(30 * time.Second)
+		metrics.DelaySecondsTotal.WithLabelValues("gas").Add(30)
+		if GasWaitHook != nil {
+			GasWaitHook(time.Since(start), gasPriceGwei.String())
+		}
 	}
 }
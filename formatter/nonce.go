@@ -0,0 +1,47 @@
+package formatter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Nonces hands out sequential nonces for one sender. It starts at the pending nonce and counts up
+// locally, so back to back sends don't depend on the node having already seen the previous one.
+type Nonces struct {
+	mu      sync.Mutex
+	address common.Address
+	next    uint64
+	loaded  bool
+}
+
+func NewNonces(address common.Address) *Nonces {
+	return &Nonces{address: address}
+}
+
+// Next reserves the next nonce
+func (n *Nonces) Next(provider *ethclient.Client) (uint64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.loaded {
+		nonce, err := provider.PendingNonceAt(context.Background(), n.address)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get nonce: %v", err)
+		}
+		n.next = nonce
+		n.loaded = true
+	}
+	nonce := n.next
+	n.next++
+	return nonce, nil
+}
+
+// Reset makes the next call read the pending nonce again, after a send failed and its nonce was not used
+func (n *Nonces) Reset() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.loaded = false
+}
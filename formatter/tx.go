@@ -2,13 +2,44 @@ package formatter
 
 import (
 	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
-	"time"
+	"github.com/fatih/color"
+	"puffDep/signer"
 )
 
+var infoText = color.New(color.FgBlue)
+
+// TxResult describes a sent and mined transaction
+type TxResult struct {
+	Hash    common.Hash
+	Nonce   uint64
+	Value   *big.Int
+	Receipt *types.Receipt
+	// ConfirmationTime is how long it took from sending until the receipt was available
+	ConfirmationTime time.Duration
+}
+
+// ExplorerTxUrl is the transaction link template of the network we run on, {hash} is replaced with the hash
+var ExplorerTxUrl = "https://etherscan.io/tx/{hash}"
+
+// Url returns the block explorer link of the transaction
+func (r *TxResult) Url() string {
+	return strings.ReplaceAll(ExplorerTxUrl, "{hash}", r.Hash.Hex())
+}
+
+// Submitter, when set, is used by send in place of the node's eth_sendRawTransaction, e.g. to go
+// through a private relay. It may block until the transaction is included.
+var Submitter func(provider *ethclient.Client, signedTx *types.Transaction) error
+
 // WaitForTransactionReceipt waits for the transaction to be mined and confirmed
 func WaitForTransactionReceipt(client *ethclient.Client, txHash common.Hash) (*types.Receipt, error) {
 	ctx := context.Background()
@@ -23,3 +54,190 @@ func WaitForTransactionReceipt(client *ethclient.Client, txHash common.Hash) (*t
 		return receipt, nil
 	}
 }
+
+// newTransactOpts returns transact opts signing with s with the next nonce and the suggested gas price
+// as both fee caps filled in, and the chain ID it signs for. Without nonces the pending nonce is used.
+// NoSend is set, the transaction is sent by send.
+func newTransactOpts(provider *ethclient.Client, s signer.Signer, nonces *Nonces) (*bind.TransactOpts, *big.Int, error) {
+	ctx := context.Background()
+	fromAddress := s.Address()
+
+	//! Get the nonce
+	if nonces == nil {
+		nonces = NewNonces(fromAddress)
+	}
+	nonce, err := nonces.Next(provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	//! Gas Price
+	gasPrice, err := provider.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get gas price: %v", err)
+	}
+
+	// ! Chain ID
+	chainID, err := provider.ChainID(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get chain ID: %v", err)
+	}
+
+	//!Data for function
+	auth := &bind.TransactOpts{
+		From: fromAddress,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != fromAddress {
+				return nil, bind.ErrNotAuthorized
+			}
+			return s.SignTx(ctx, tx, chainID)
+		},
+		Context:   ctx,
+		Nonce:     new(big.Int).SetUint64(nonce),
+		GasTipCap: gasPrice,
+		GasFeeCap: gasPrice,
+		NoSend:    true,
+	}
+	return auth, chainID, nil
+}
+
+// Transfer sends value of ETH to `to`, taking the nonce from nonces. A send that fails before the
+// transaction reached the node resets nonces, so the nonce is not skipped.
+func Transfer(provider *ethclient.Client, s signer.Signer, to common.Address, value *big.Int, nonces *Nonces) (*TxResult, error) {
+	result, err := sendTransaction(provider, s, to, value, nonces)
+	if err != nil && result == nil {
+		nonces.Reset()
+	}
+	return result, err
+}
+
+// sendTransaction signs a dynamic fee transaction sending value of ETH to `to`, sends it and waits
+// for the receipt. A transaction that was mined but reverted is returned together with an error.
+func sendTransaction(provider *ethclient.Client, s signer.Signer, to common.Address, value *big.Int, nonces *Nonces) (*TxResult, error) {
+	auth, chainID, err := newTransactOpts(provider, s, nonces)
+	if err != nil {
+		return nil, err
+	}
+
+	// ! GasLimit
+	gasLimit, err := provider.EstimateGas(auth.Context, ethereum.CallMsg{
+		From:  auth.From,
+		To:    &to,
+		Value: value,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %v", err)
+	}
+
+	return signAndSend(provider, auth, chainID, to, value, gasLimit)
+}
+
+// TransferAll sends the whole ETH balance to `to`, less the gas cost. The tip is the fee cap, so the
+// transaction pays gas used * fee cap. Sending to an account the gas used is the estimate and nothing
+// is left behind; a contract destination may use less gas than estimated, the unused gas * fee cap
+// then stays in the wallet. It returns the value sent.
+func TransferAll(provider *ethclient.Client, s signer.Signer, to common.Address) (*TxResult, *big.Int, error) {
+	auth, chainID, err := newTransactOpts(provider, s, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balance, err := provider.BalanceAt(auth.Context, auth.From, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get wallet balance: %v", err)
+	}
+
+	// ! GasLimit
+	gasLimit, err := provider.EstimateGas(auth.Context, ethereum.CallMsg{From: auth.From, To: &to})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to estimate gas: %v", err)
+	}
+
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), auth.GasFeeCap)
+	value := new(big.Int).Sub(balance, gasCost)
+	if value.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("balance %s wei does not cover the gas cost of %s wei", balance, gasCost)
+	}
+
+	result, err := signAndSend(provider, auth, chainID, to, value, gasLimit)
+	return result, value, err
+}
+
+// signAndSend builds a dynamic fee ETH transfer from the opts, signs and sends it
+func signAndSend(provider *ethclient.Client, auth *bind.TransactOpts, chainID *big.Int, to common.Address, value *big.Int, gasLimit uint64) (*TxResult, error) {
+	//! Construct the transaction
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     auth.Nonce.Uint64(),
+		GasTipCap: auth.GasTipCap,
+		GasFeeCap: auth.GasFeeCap,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+	})
+
+	//! Sign the transaction
+	signedTx, err := auth.Signer(auth.From, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %v", err)
+	}
+	return send(provider, auth.From, signedTx)
+}
+
+// Transact sends a contract call made through a typed binding. build gets transact opts with the
+// nonce, fees and value filled in; the binding estimates gas and signs, and the signed transaction
+// is sent and waited for like a Transfer.
+func Transact(provider *ethclient.Client, s signer.Signer, value *big.Int, build func(opts *bind.TransactOpts) (*types.Transaction, error)) (*TxResult, error) {
+	auth, _, err := newTransactOpts(provider, s, nil)
+	if err != nil {
+		return nil, err
+	}
+	auth.Value = value
+
+	signedTx, err := build(auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction: %v", err)
+	}
+	return send(provider, auth.From, signedTx)
+}
+
+// send checks that from can pay for the signed transaction, sends it and waits for the receipt
+func send(provider *ethclient.Client, fromAddress common.Address, signedTx *types.Transaction) (*TxResult, error) {
+	ctx := context.Background()
+
+	//! Check if the wallet has enough balance
+	walletBalance, err := provider.BalanceAt(ctx, fromAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet balance: %v", err)
+	}
+	transactionPrice, hasEnoughBalance := GetTransactionCost(signedTx.Gas(), signedTx.GasFeeCap(), signedTx.Value(), walletBalance)
+	if !hasEnoughBalance {
+		return nil, fmt.Errorf("insufficient balance, transaction cost: %v", transactionPrice)
+	}
+
+	//! Send the transaction
+	sentAt := time.Now()
+	if Submitter != nil {
+		err = Submitter(provider, signedTx)
+	} else {
+		err = provider.SendTransaction(ctx, signedTx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %v", err)
+	}
+	infoText.Printf("Transaction sent: %s\n", signedTx.Hash().Hex())
+
+	result := &TxResult{Hash: signedTx.Hash(), Nonce: signedTx.Nonce(), Value: signedTx.Value()}
+	receipt, err := WaitForTransactionReceipt(provider, signedTx.Hash())
+	if err != nil {
+		return result, fmt.Errorf("failed to get transaction receipt: %v", err)
+	}
+	result.Receipt = receipt
+	result.ConfirmationTime = time.Since(sentAt)
+	fmt.Printf("Transaction confirmed in block: %d\n", receipt.BlockNumber.Uint64())
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return result, fmt.Errorf("transaction %s reverted", signedTx.Hash().Hex())
+	}
+	return result, nil
+}
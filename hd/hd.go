@@ -0,0 +1,148 @@
+package hd
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// hardened is the first hardened child index, written with ' in paths
+const hardened = 0x80000000
+
+// DefaultPath is the derivation path template of most Ethereum wallets
+const DefaultPath = "m/44'/60'/0'/0/{index}"
+
+// NewMnemonic generates a BIP-39 mnemonic of 12 or 24 words
+func NewMnemonic(words int) (string, error) {
+	var bits int
+	switch words {
+	case 12:
+		bits = 128
+	case 24:
+		bits = 256
+	default:
+		return "", fmt.Errorf("a mnemonic has 12 or 24 words, not %d", words)
+	}
+	entropy, err := bip39.NewEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// Seed checks the mnemonic's words and checksum and returns its BIP-39 seed
+func Seed(mnemonic string, passphrase string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(strings.Join(strings.Fields(mnemonic), " "), passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %v", err)
+	}
+	return seed, nil
+}
+
+// PathFor fills index into the path template, e.g. m/44'/60'/0'/0/{index}
+func PathFor(template string, index int) (accounts.DerivationPath, error) {
+	if !strings.Contains(template, "{index}") {
+		return nil, fmt.Errorf("derivation path %q has no {index}", template)
+	}
+	path, err := accounts.ParseDerivationPath(strings.ReplaceAll(template, "{index}", strconv.Itoa(index)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path %q: %v", template, err)
+	}
+	return path, nil
+}
+
+// Derive returns the BIP-32 private key of path under seed
+func Derive(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	n := crypto.S256().Params().N
+
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := new(big.Int).SetBytes(sum[:32]), sum[32:]
+	if key.Sign() == 0 || key.Cmp(n) >= 0 {
+		return nil, fmt.Errorf("seed gives an invalid master key")
+	}
+
+	for _, index := range path {
+		data := make([]byte, 0, 37)
+		if index >= hardened {
+			data = append(data, 0)
+			data = append(data, math.PaddedBigBytes(key, 32)...)
+		} else {
+			parent, err := crypto.ToECDSA(math.PaddedBigBytes(key, 32))
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, crypto.CompressPubkey(&parent.PublicKey)...)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+		tweak := new(big.Int).SetBytes(sum[:32])
+		if tweak.Cmp(n) >= 0 {
+			return nil, fmt.Errorf("path %s gives an invalid key", path)
+		}
+		key = new(big.Int).Mod(tweak.Add(tweak, key), n)
+		if key.Sign() == 0 {
+			return nil, fmt.Errorf("path %s gives an invalid key", path)
+		}
+		chainCode = sum[32:]
+	}
+	return crypto.ToECDSA(math.PaddedBigBytes(key, 32))
+}
+
+// ReadMnemonicFile decrypts a mnemonic file written by WriteMnemonicFile
+func ReadMnemonicFile(path string, password string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read mnemonic file: %v", err)
+	}
+	var encrypted keystore.CryptoJSON
+	if err := json.Unmarshal(data, &encrypted); err != nil {
+		return "", fmt.Errorf("failed to parse mnemonic file %s: %v", path, err)
+	}
+	mnemonic, err := keystore.DecryptDataV3(encrypted, password)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt mnemonic file %s: %v", path, err)
+	}
+	return string(mnemonic), nil
+}
+
+// WriteMnemonicFile encrypts the mnemonic with password like a V3 keystore and writes it to a new file
+func WriteMnemonicFile(path string, mnemonic string, password string) error {
+	if password == "" {
+		return fmt.Errorf("refusing to write the mnemonic without a password")
+	}
+	encrypted, err := keystore.EncryptDataV3([]byte(mnemonic), []byte(password), keystore.StandardScryptN, keystore.StandardScryptP)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt mnemonic: %v", err)
+	}
+	data, err := json.MarshalIndent(encrypted, "", "  ")
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create mnemonic file: %v", err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write mnemonic file: %v", err)
+	}
+	return file.Close()
+}
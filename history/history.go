@@ -0,0 +1,190 @@
+package history
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"puffDep/journal"
+)
+
+// Buckets: steps holds every record by sequence, the others index it
+var (
+	bucketSteps       = []byte("steps")
+	bucketRuns        = []byte("runs")
+	bucketRunSteps    = []byte("runSteps")
+	bucketWalletSteps = []byte("walletSteps")
+	bucketTxs         = []byte("txs")
+)
+
+// lockTimeout is how long to wait for another process writing to the database
+const lockTimeout = 10 * time.Second
+
+// DB is the transaction history: runs, and the steps of every wallet with their transactions,
+// receipts and gas costs. The file is opened for every operation, so queries can run next to a
+// running command.
+type DB struct {
+	path string
+}
+
+func Open(path string) *DB {
+	return &DB{path: path}
+}
+
+// Step is a journal record stored in the history, Seq orders steps by the time they were written
+type Step struct {
+	Seq uint64 `json:"seq"`
+	journal.Record
+}
+
+// Cost is the gas paid for the step's transaction in wei, zero without a receipt
+func (s *Step) Cost() *big.Int {
+	price, ok := new(big.Int).SetString(s.EffectiveGasPrice, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return price.Mul(price, new(big.Int).SetUint64(s.GasUsed))
+}
+
+// Run sums up the steps written under a run ID
+type Run struct {
+	ID        string    `json:"id"`
+	Started   time.Time `json:"started"`
+	Updated   time.Time `json:"updated"`
+	Steps     int       `json:"steps"`
+	Succeeded int       `json:"succeeded"`
+	Failed    int       `json:"failed"`
+	Skipped   int       `json:"skipped"`
+	// ByStep counts the steps of the run by name, it tells a deposit run from a fund or sweep run
+	ByStep map[string]int `json:"byStep"`
+	// CostWei is the gas paid by all steps of the run
+	CostWei string `json:"costWei"`
+}
+
+func (db *DB) update(fn func(tx *bolt.Tx) error) error {
+	bdb, err := bolt.Open(db.path, 0600, &bolt.Options{Timeout: lockTimeout})
+	if err != nil {
+		return fmt.Errorf("failed to open history %s: %v", db.path, err)
+	}
+	defer bdb.Close()
+	return bdb.Update(fn)
+}
+
+// view runs fn on a read only transaction, a database that doesn't exist yet is empty
+func (db *DB) view(fn func(tx *bolt.Tx) error) error {
+	if _, err := os.Stat(db.path); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	bdb, err := bolt.Open(db.path, 0600, &bolt.Options{Timeout: lockTimeout, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to open history %s: %v", db.path, err)
+	}
+	defer bdb.Close()
+	return bdb.View(fn)
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+// walletKey is the index key of a wallet, addresses are compared case insensitively
+func walletKey(wallet string) []byte {
+	return []byte(strings.ToLower(wallet))
+}
+
+// Write stores the record as a step, indexes it by run, wallet and transaction and adds it to its run
+func (db *DB) Write(rec journal.Record) error {
+	if rec.Time.IsZero() {
+		rec.Time = time.Now().UTC()
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		steps, err := tx.CreateBucketIfNotExists(bucketSteps)
+		if err != nil {
+			return err
+		}
+		seq, err := steps.NextSequence()
+		if err != nil {
+			return err
+		}
+		step := Step{Seq: seq, Record: rec}
+		value, err := json.Marshal(step)
+		if err != nil {
+			return err
+		}
+		key := seqKey(seq)
+		if err := steps.Put(key, value); err != nil {
+			return err
+		}
+
+		//! Indexes
+		for _, index := range []struct{ bucket, name []byte }{
+			{bucketRunSteps, []byte(rec.RunID)},
+			{bucketWalletSteps, walletKey(rec.Wallet)},
+		} {
+			parent, err := tx.CreateBucketIfNotExists(index.bucket)
+			if err != nil {
+				return err
+			}
+			child, err := parent.CreateBucketIfNotExists(index.name)
+			if err != nil {
+				return err
+			}
+			if err := child.Put(key, nil); err != nil {
+				return err
+			}
+		}
+		if rec.TxHash != "" {
+			txs, err := tx.CreateBucketIfNotExists(bucketTxs)
+			if err != nil {
+				return err
+			}
+			if err := txs.Put([]byte(strings.ToLower(rec.TxHash)), key); err != nil {
+				return err
+			}
+		}
+
+		//! Run summary
+		runs, err := tx.CreateBucketIfNotExists(bucketRuns)
+		if err != nil {
+			return err
+		}
+		run := Run{ID: rec.RunID, Started: rec.Time, CostWei: "0"}
+		if existing := runs.Get([]byte(rec.RunID)); existing != nil {
+			if err := json.Unmarshal(existing, &run); err != nil {
+				return err
+			}
+		}
+		run.Updated = rec.Time
+		run.Steps++
+		if run.ByStep == nil {
+			run.ByStep = make(map[string]int)
+		}
+		run.ByStep[rec.Step]++
+		switch rec.Status {
+		case journal.StatusSuccess:
+			run.Succeeded++
+		case journal.StatusFailed:
+			run.Failed++
+		case journal.StatusSkipped:
+			run.Skipped++
+		}
+		cost, _ := new(big.Int).SetString(run.CostWei, 10)
+		if cost == nil {
+			cost = new(big.Int)
+		}
+		run.CostWei = cost.Add(cost, step.Cost()).String()
+		value, err = json.Marshal(run)
+		if err != nil {
+			return err
+		}
+		return runs.Put([]byte(rec.RunID), value)
+	})
+}
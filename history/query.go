@@ -0,0 +1,190 @@
+package history
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	bolt "go.etcd.io/bbolt"
+	"puffDep/journal"
+)
+
+// Filter selects steps, empty fields match everything. Limit keeps the most recent steps.
+type Filter struct {
+	RunID   string
+	Wallets []string
+	Step    string
+	Status  string
+	Limit   int
+}
+
+func (f Filter) match(s *Step) bool {
+	if f.RunID != "" && s.RunID != f.RunID {
+		return false
+	}
+	if len(f.Wallets) > 0 {
+		found := false
+		for _, w := range f.Wallets {
+			found = found || strings.EqualFold(w, s.Wallet)
+		}
+		if !found {
+			return false
+		}
+	}
+	return (f.Step == "" || s.Step == f.Step) && (f.Status == "" || s.Status == f.Status)
+}
+
+// Steps returns the steps matching the filter, oldest first. A run or wallet filter is
+// answered from its index instead of scanning every step.
+func (db *DB) Steps(f Filter) ([]Step, error) {
+	var result []Step
+	err := db.view(func(tx *bolt.Tx) error {
+		steps := tx.Bucket(bucketSteps)
+		if steps == nil {
+			return nil
+		}
+
+		add := func(value []byte) error {
+			var s Step
+			if err := json.Unmarshal(value, &s); err != nil {
+				return err
+			}
+			if f.match(&s) {
+				result = append(result, s)
+			}
+			return nil
+		}
+
+		var keys [][]byte
+		switch {
+		case f.RunID != "":
+			keys = indexKeys(tx, bucketRunSteps, [][]byte{[]byte(f.RunID)})
+		case len(f.Wallets) > 0:
+			names := make([][]byte, len(f.Wallets))
+			for i, w := range f.Wallets {
+				names[i] = walletKey(w)
+			}
+			keys = indexKeys(tx, bucketWalletSteps, names)
+		default:
+			return steps.ForEach(func(_, value []byte) error {
+				return add(value)
+			})
+		}
+		for _, key := range keys {
+			if value := steps.Get(key); value != nil {
+				if err := add(value); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Seq < result[j].Seq })
+	if f.Limit > 0 && len(result) > f.Limit {
+		result = result[len(result)-f.Limit:]
+	}
+	return result, nil
+}
+
+// indexKeys returns the step keys under the named child buckets of an index bucket
+func indexKeys(tx *bolt.Tx, index []byte, names [][]byte) [][]byte {
+	parent := tx.Bucket(index)
+	if parent == nil {
+		return nil
+	}
+	var keys [][]byte
+	for _, name := range names {
+		child := parent.Bucket(name)
+		if child == nil {
+			continue
+		}
+		child.ForEach(func(key, _ []byte) error {
+			keys = append(keys, append([]byte(nil), key...))
+			return nil
+		})
+	}
+	return keys
+}
+
+// Records returns the journal records of the run, oldest first
+func (db *DB) Records(runID string) ([]journal.Record, error) {
+	steps, err := db.Steps(Filter{RunID: runID})
+	if err != nil {
+		return nil, err
+	}
+	records := make([]journal.Record, len(steps))
+	for i, s := range steps {
+		records[i] = s.Record
+	}
+	return records, nil
+}
+
+// Runs returns every run, the most recently started last
+func (db *DB) Runs() ([]Run, error) {
+	var runs []Run
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketRuns)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(_, value []byte) error {
+			var run Run
+			if err := json.Unmarshal(value, &run); err != nil {
+				return err
+			}
+			runs = append(runs, run)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(runs, func(i, j int) bool { return runs[i].Started.Before(runs[j].Started) })
+	return runs, nil
+}
+
+// LastRun returns the ID of the most recently started run that has any of the steps, any run when
+// no steps are given. It is empty when there is none.
+func (db *DB) LastRun(steps ...string) (string, error) {
+	runs, err := db.Runs()
+	if err != nil {
+		return "", err
+	}
+	for i := len(runs) - 1; i >= 0; i-- {
+		if len(steps) == 0 {
+			return runs[i].ID, nil
+		}
+		for _, step := range steps {
+			if runs[i].ByStep[step] > 0 {
+				return runs[i].ID, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// Tx returns the step that sent the transaction, nil when it is not in the history
+func (db *DB) Tx(hash string) (*Step, error) {
+	var step *Step
+	err := db.view(func(tx *bolt.Tx) error {
+		txs, steps := tx.Bucket(bucketTxs), tx.Bucket(bucketSteps)
+		if txs == nil || steps == nil {
+			return nil
+		}
+		key := txs.Get([]byte(strings.ToLower(hash)))
+		if key == nil {
+			return nil
+		}
+		value := steps.Get(key)
+		if value == nil {
+			return nil
+		}
+		step = &Step{}
+		return json.Unmarshal(value, step)
+	})
+	return step, err
+}
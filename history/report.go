@@ -0,0 +1,139 @@
+package history
+
+import (
+	"math/big"
+	"sort"
+
+	"puffDep/journal"
+)
+
+// Amount sums the amounts of the successful steps of one name on one target. Targets are kept
+// apart since their amounts are in different units.
+type Amount struct {
+	Step   string `json:"step"`
+	Target string `json:"target,omitempty"`
+	Count  int    `json:"count"`
+	Wei    string `json:"wei"`
+}
+
+// WalletReport sums up the steps of a wallet
+type WalletReport struct {
+	Wallet    string `json:"wallet"`
+	Steps     int    `json:"steps"`
+	Succeeded int    `json:"succeeded"`
+	Failed    int    `json:"failed"`
+	Skipped   int    `json:"skipped"`
+	// CostWei is the gas paid by the wallet's steps, and by the master key funding it
+	CostWei string   `json:"costWei"`
+	Amounts []Amount `json:"amounts"`
+	// LastStep and LastStatus are of the most recent step, LastError is set when it failed
+	LastStep   string `json:"lastStep"`
+	LastStatus string `json:"lastStatus"`
+	LastError  string `json:"lastError,omitempty"`
+}
+
+// Amount returns the summed amount of step on target, nil when there is none
+func (w *WalletReport) Amount(step string, target string) *Amount {
+	for i := range w.Amounts {
+		if w.Amounts[i].Step == step && w.Amounts[i].Target == target {
+			return &w.Amounts[i]
+		}
+	}
+	return nil
+}
+
+// Report is the history of every wallet matching a filter, in the order the wallets first show up
+type Report struct {
+	Wallets []WalletReport `json:"wallets"`
+	Totals  WalletReport   `json:"totals"`
+}
+
+type amountKey struct{ step, target string }
+
+// tally sums up steps into a WalletReport
+type tally struct {
+	report  WalletReport
+	cost    *big.Int
+	amounts map[amountKey]*big.Int
+	counts  map[amountKey]int
+}
+
+func newTally(wallet string) *tally {
+	return &tally{
+		report:  WalletReport{Wallet: wallet},
+		cost:    new(big.Int),
+		amounts: make(map[amountKey]*big.Int),
+		counts:  make(map[amountKey]int),
+	}
+}
+
+func (t *tally) add(s *Step) {
+	t.report.Steps++
+	switch s.Status {
+	case journal.StatusSuccess:
+		t.report.Succeeded++
+	case journal.StatusFailed:
+		t.report.Failed++
+	case journal.StatusSkipped:
+		t.report.Skipped++
+	}
+	t.cost.Add(t.cost, s.Cost())
+	t.report.LastStep, t.report.LastStatus, t.report.LastError = s.Step, s.Status, s.Error
+
+	amount, ok := new(big.Int).SetString(s.AmountWei, 10)
+	if s.Status != journal.StatusSuccess || !ok {
+		return
+	}
+	key := amountKey{s.Step, s.Target}
+	if t.amounts[key] == nil {
+		t.amounts[key] = new(big.Int)
+	}
+	t.amounts[key].Add(t.amounts[key], amount)
+	t.counts[key]++
+}
+
+func (t *tally) done() WalletReport {
+	t.report.CostWei = t.cost.String()
+	t.report.Amounts = make([]Amount, 0, len(t.amounts))
+	for key, wei := range t.amounts {
+		t.report.Amounts = append(t.report.Amounts, Amount{Step: key.step, Target: key.target, Count: t.counts[key], Wei: wei.String()})
+	}
+	sort.Slice(t.report.Amounts, func(i, j int) bool {
+		a, b := t.report.Amounts[i], t.report.Amounts[j]
+		if a.Step != b.Step {
+			return a.Step < b.Step
+		}
+		return a.Target < b.Target
+	})
+	return t.report
+}
+
+// Report sums up the steps matching the filter per wallet, with totals over all of them
+func (db *DB) Report(f Filter) (*Report, error) {
+	steps, err := db.Steps(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var order []string
+	wallets := make(map[string]*tally)
+	totals := newTally("")
+	for i := range steps {
+		key := string(walletKey(steps[i].Wallet))
+		t, ok := wallets[key]
+		if !ok {
+			t = newTally(steps[i].Wallet)
+			wallets[key] = t
+			order = append(order, key)
+		}
+		t.add(&steps[i])
+		totals.add(&steps[i])
+	}
+
+	report := &Report{Wallets: make([]WalletReport, 0, len(order)), Totals: totals.done()}
+	report.Totals.LastStep, report.Totals.LastStatus, report.Totals.LastError = "", "", ""
+	for _, key := range order {
+		report.Wallets = append(report.Wallets, wallets[key].done())
+	}
+	return report, nil
+}
@@ -0,0 +1,167 @@
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"puffDep/formatter"
+)
+
+// Record is a single line of the journal. Amounts and gas prices are in wei.
+type Record struct {
+	Time   time.Time `json:"time"`
+	RunID  string    `json:"runId"`
+	Wallet string    `json:"wallet"`
+	// From is the sender when it is not the wallet itself, e.g. the master key funding it
+	From string `json:"from,omitempty"`
+	Step string `json:"step"`
+	// Target is the deposit asset or Karak vault the step worked on, AmountWei is in its units
+	Target            string  `json:"target,omitempty"`
+	TxHash            string  `json:"txHash,omitempty"`
+	Nonce             *uint64 `json:"nonce,omitempty"`
+	AmountWei         string  `json:"amountWei,omitempty"`
+	GasUsed           uint64  `json:"gasUsed,omitempty"`
+	EffectiveGasPrice string  `json:"effectiveGasPrice,omitempty"`
+	Block             uint64  `json:"block,omitempty"`
+	Status            string  `json:"status"`
+	Error             string  `json:"error,omitempty"`
+}
+
+const (
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+)
+
+// FromTx fills the transaction fields of the record from a sent transaction, tx may be nil
+func (r *Record) FromTx(tx *formatter.TxResult) {
+	if tx == nil {
+		return
+	}
+	r.TxHash = tx.Hash.Hex()
+	nonce := tx.Nonce
+	r.Nonce = &nonce
+	if tx.Receipt != nil {
+		r.GasUsed = tx.Receipt.GasUsed
+		if tx.Receipt.EffectiveGasPrice != nil {
+			r.EffectiveGasPrice = tx.Receipt.EffectiveGasPrice.String()
+		}
+		if tx.Receipt.BlockNumber != nil {
+			r.Block = tx.Receipt.BlockNumber.Uint64()
+		}
+	}
+}
+
+// Journal appends records as json lines to a file and rotates it once it grows past maxSize.
+// It is safe for concurrent use.
+type Journal struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// Open opens or creates the journal at path. maxSizeMB <= 0 disables rotation,
+// maxBackups <= 0 keeps every rotated file.
+func Open(path string, maxSizeMB int, maxBackups int) (*Journal, error) {
+	j := &Journal{
+		path:       path,
+		maxSize:    int64(maxSizeMB) * 1024 * 1024,
+		maxBackups: maxBackups,
+	}
+	if err := j.open(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *Journal) open() error {
+	file, err := os.OpenFile(j.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open journal: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat journal: %v", err)
+	}
+	j.file = file
+	j.size = info.Size()
+	return nil
+}
+
+// Write appends a record, setting its time if it is empty
+func (j *Journal) Write(r Record) error {
+	if r.Time.IsZero() {
+		r.Time = time.Now().UTC()
+	}
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.maxSize > 0 && j.size > 0 && j.size+int64(len(line)) > j.maxSize {
+		if err := j.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := j.file.Write(line)
+	j.size += int64(n)
+	return err
+}
+
+// Rotate closes the current file, renames it with a timestamp suffix and starts a new one.
+// It can be called from outside, e.g. on SIGHUP.
+func (j *Journal) Rotate() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.rotate()
+}
+
+func (j *Journal) rotate() error {
+	if err := j.file.Close(); err != nil {
+		return err
+	}
+	backup := fmt.Sprintf("%s.%s", j.path, time.Now().UTC().Format("20060102T150405.000"))
+	if err := os.Rename(j.path, backup); err != nil {
+		return fmt.Errorf("failed to rotate journal: %v", err)
+	}
+	if err := j.open(); err != nil {
+		return err
+	}
+	return j.removeOldBackups()
+}
+
+func (j *Journal) removeOldBackups() error {
+	if j.maxBackups <= 0 {
+		return nil
+	}
+	// the timestamp suffix sorts chronologically, so Glob's sorted output is oldest first
+	backups, err := filepath.Glob(j.path + ".*")
+	if err != nil {
+		return err
+	}
+	for len(backups) > j.maxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.file.Close()
+}
@@ -0,0 +1,47 @@
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Read returns the records of the journal at path, rotated files first, oldest record first.
+// Lines that are not valid records are skipped.
+func Read(path string) ([]Record, error) {
+	// the timestamp suffix sorts chronologically, so Glob's sorted output is oldest first
+	files, err := filepath.Glob(path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, path)
+
+	var records []Record
+	for _, name := range files {
+		file, err := os.Open(name)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to open journal: %v", err)
+		}
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			var r Record
+			if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+				continue
+			}
+			records = append(records, r)
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read journal %s: %v", name, err)
+		}
+	}
+	return records, nil
+}
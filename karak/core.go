@@ -1,138 +1,68 @@
 package karak
 
 import (
-	"context"
-	"crypto/ecdsa"
 	"fmt"
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/fatih/color"
-	"log"
 	"math/big"
 	"puffDep/config"
+	"puffDep/contracts/vaultsupervisor"
 	"puffDep/formatter"
-	"strings"
+	"puffDep/signer"
 )
 
 var InfoText = color.New(color.FgBlue)
-var karakVaultContract = "0x54e44DbB92dBA848ACe27F44c0CB4268981eF1CC"
 
-var karakVaultAddress = "0x68754d29f2e97B837Cb622ccfF325adAC27E9977"
-
-var karakABI = `[{"inputs":[{"internalType":"contract IVault","name":"vault","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"uint256","name":"minSharesOut","type":"uint256"}],"name":"deposit","outputs":[{"internalType":"uint256","name":"shares","type":"uint256"}],"stateMutability":"nonpayable","type":"function"}]`
-
-func DepositToKarak(provider *ethclient.Client, privateKeyECDSA *ecdsa.PrivateKey, amountPuffEth *big.Int, cfg *config.Config) string {
-	ctx := context.Background()
-
-	contractAddress := common.HexToAddress(karakVaultContract)
-
-	fromAddress := crypto.PubkeyToAddress(privateKeyECDSA.PublicKey)
-
-	//! Parsed ABI
-	parsedABI, err := abi.JSON(strings.NewReader(karakABI))
-	if err != nil {
-		log.Printf("Failed to parse contract ABI: %v", err)
-	}
-
-	//! Get the nonce
-	nonce, err := provider.PendingNonceAt(ctx, fromAddress)
-	if err != nil {
-		log.Printf("Failed to get nonce: %v", err)
-	}
-
-	//! Gas Price
-	gasPrice, err := provider.SuggestGasPrice(context.Background())
-	if err != nil {
-		log.Printf("Failed to get gas price: %v", err)
-	}
-
-	// ! Chain ID
-	chainID, err := provider.ChainID(context.Background())
-	if err != nil {
-		log.Printf("Failed to get chain ID: %v", err)
-	}
-
-	minShareOut := formatter.CalculateSlippage(amountPuffEth) // ! 1% slippage
-
-	// ! Calldata
-	callData, err := parsedABI.Pack("deposit", common.HexToAddress(karakVaultAddress), amountPuffEth, minShareOut)
+// bindSupervisor binds the VaultSupervisor deposits and withdrawals go through
+func bindSupervisor(provider *ethclient.Client, supervisor common.Address) (*vaultsupervisor.VaultSupervisor, error) {
+	bound, err := vaultsupervisor.NewVaultSupervisor(supervisor, provider)
 	if err != nil {
-		log.Printf("Failed to pack function input: %v", err)
+		return nil, fmt.Errorf("failed to bind vault supervisor %s: %v", supervisor.Hex(), err)
 	}
+	return bound, nil
+}
 
-	formatter.CheckGasPrice(provider, cfg)
-
-	// ! GasLimit
-	gasLimit, err := provider.EstimateGas(context.Background(), ethereum.CallMsg{
-		From: fromAddress,
-		To:   &contractAddress,
-		Data: callData,
-	})
+func DepositToKarak(provider *ethclient.Client, s signer.Signer, vault Vault, amount *big.Int, cfg *config.Holder) (*formatter.TxResult, error) {
+	supervisor, err := bindSupervisor(provider, vault.Supervisor)
 	if err != nil {
-		log.Printf("Failed to estimate gas: %v", err)
+		return nil, err
 	}
 
-	//! Get wallet balance
-	walletBalance, err := provider.BalanceAt(ctx, fromAddress, nil)
+	//! Expected shares for the amount
+	expectedShares, err := ConvertToShares(provider, vault, amount)
 	if err != nil {
-		log.Printf("Failed to get wallet balance: %v", err)
+		return nil, err
 	}
+	minShareOut := formatter.CalculateSlippage(expectedShares) // ! 1% slippage
 
-	//! Check if the wallet has enough balance
-	transactionPrice, hasEnoughBalance := formatter.GetTransactionCost(gasLimit, gasPrice, big.NewInt(0), walletBalance)
-	if !hasEnoughBalance {
-		log.Printf("Insufficient balance. Transaction cost: %v", transactionPrice)
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, err
 	}
 
-	//!Data for function
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKeyECDSA, chainID)
-	if err != nil {
-		log.Printf("Failed to create keyed transactor %v", err)
-	}
-
-	auth.Nonce = big.NewInt(int64(nonce))
-	auth.Value = big.NewInt(0)
-	auth.GasLimit = gasLimit
-	auth.GasPrice = gasPrice
-
-	//! Construct the transaction
-	tx := types.NewTx(&types.DynamicFeeTx{
-		ChainID:   chainID,
-		Nonce:     nonce,
-		GasTipCap: gasPrice,
-		GasFeeCap: gasPrice,
-		Gas:       auth.GasLimit,
-		To:        &contractAddress,
-		Value:     auth.Value,
-		Data:      callData,
+	return formatter.Transact(provider, s, big.NewInt(0), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return supervisor.Deposit(opts, vault.Address, amount, minShareOut)
 	})
+}
 
-	//! Sign the transaction
-	signedTx, err := auth.Signer(fromAddress, tx)
-	if err != nil {
-		log.Printf("Failed to sign transaction: %v", err)
-	}
-
-	//! Send the transaction
-	err = provider.SendTransaction(context.Background(), signedTx)
-	if err != nil {
-		log.Printf("Failed to send transaction: %v", err)
+// Allocate splits amount between the vaults by their weights, the last vault gets the rounding remainder
+func Allocate(amount *big.Int, vaults []Vault) []*big.Int {
+	totalWeight := 0
+	for _, v := range vaults {
+		totalWeight += v.Weight
 	}
 
-	InfoText.Printf("Transaction sent: %s\n", signedTx.Hash().Hex())
-
-	receipt, err := formatter.WaitForTransactionReceipt(provider, signedTx.Hash())
-	if err != nil {
-		log.Printf("Failed to get transaction receipt: %v", err)
+	parts := make([]*big.Int, len(vaults))
+	rest := new(big.Int).Set(amount)
+	for i, v := range vaults {
+		if i == len(vaults)-1 {
+			parts[i] = rest
+			break
+		}
+		parts[i] = new(big.Int).Div(new(big.Int).Mul(amount, big.NewInt(int64(v.Weight))), big.NewInt(int64(totalWeight)))
+		rest = new(big.Int).Sub(rest, parts[i])
 	}
-
-	fmt.Printf("Transaction confirmed in block: %d\n", receipt.BlockNumber.Uint64())
-
-	return "https://etherscan.io/tx/" + signedTx.Hash().Hex()
-
+	return parts
 }
@@ -0,0 +1,112 @@
+package karak
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Withdrawal is a queued Karak withdrawal we started and still have to, or already did, finish
+type Withdrawal struct {
+	Wallet     common.Address   `json:"wallet"`
+	Supervisor common.Address   `json:"supervisor"`
+	Root       common.Hash      `json:"root"`
+	Queued     QueuedWithdrawal `json:"queued"`
+	StartTx    string           `json:"startTx"`
+	FinishTx   string           `json:"finishTx,omitempty"`
+	FinishedAt *time.Time       `json:"finishedAt,omitempty"`
+}
+
+// WithdrawalStore keeps started withdrawals in a json file, finishing one needs the exact queued withdrawal
+type WithdrawalStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewWithdrawalStore(path string) *WithdrawalStore {
+	return &WithdrawalStore{path: path}
+}
+
+func (s *WithdrawalStore) load() ([]Withdrawal, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read withdrawals: %v", err)
+	}
+	var withdrawals []Withdrawal
+	if err := json.Unmarshal(data, &withdrawals); err != nil {
+		return nil, fmt.Errorf("failed to decode withdrawals: %v", err)
+	}
+	return withdrawals, nil
+}
+
+func (s *WithdrawalStore) save(withdrawals []Withdrawal) error {
+	data, err := json.MarshalIndent(withdrawals, "", "  ")
+	if err != nil {
+		return err
+	}
+	// write to a temp file first so a crash never leaves a truncated store behind
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write withdrawals: %v", err)
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// All returns every recorded withdrawal
+func (s *WithdrawalStore) All() ([]Withdrawal, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load()
+}
+
+// Pending returns the unfinished withdrawals of wallet
+func (s *WithdrawalStore) Pending(wallet common.Address) ([]Withdrawal, error) {
+	all, err := s.All()
+	if err != nil {
+		return nil, err
+	}
+	var pending []Withdrawal
+	for _, w := range all {
+		if w.Wallet == wallet && w.FinishedAt == nil {
+			pending = append(pending, w)
+		}
+	}
+	return pending, nil
+}
+
+func (s *WithdrawalStore) Add(w Withdrawal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.load()
+	if err != nil {
+		return err
+	}
+	return s.save(append(all, w))
+}
+
+// MarkFinished records the transaction that finished the withdrawal with the given root
+func (s *WithdrawalStore) MarkFinished(root common.Hash, finishTx string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.load()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for i := range all {
+		if all[i].Root == root {
+			all[i].FinishTx = finishTx
+			all[i].FinishedAt = &now
+			return s.save(all)
+		}
+	}
+	return fmt.Errorf("withdrawal %s not found", root.Hex())
+}
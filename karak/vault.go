@@ -0,0 +1,134 @@
+package karak
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"puffDep/config"
+	"puffDep/contracts/karakvault"
+	"puffDep/multicall"
+)
+
+// Vault is a Karak vault we restake into, deposits go through its VaultSupervisor
+type Vault struct {
+	Name       string
+	Supervisor common.Address
+	Address    common.Address
+	Asset      common.Address
+	Weight     int
+}
+
+// Vaults returns the vaults declared in the config
+func Vaults(cfg *config.Config) ([]Vault, error) {
+	var vaults []Vault
+	for i, v := range cfg.Karak.Vaults {
+		for field, value := range map[string]string{"supervisor": v.Supervisor, "vault": v.Vault, "asset": v.Asset} {
+			if !common.IsHexAddress(value) {
+				return nil, fmt.Errorf("karak.vaults[%d].%s: invalid address %q", i, field, value)
+			}
+		}
+		if v.Weight <= 0 {
+			return nil, fmt.Errorf("karak.vaults[%d].weight must be positive", i)
+		}
+		name := v.Name
+		if name == "" {
+			name = v.Vault
+		}
+		vaults = append(vaults, Vault{
+			Name:       name,
+			Supervisor: common.HexToAddress(v.Supervisor),
+			Address:    common.HexToAddress(v.Vault),
+			Asset:      common.HexToAddress(v.Asset),
+			Weight:     v.Weight,
+		})
+	}
+	if len(vaults) == 0 {
+		return nil, fmt.Errorf("no karak vaults configured")
+	}
+	return vaults, nil
+}
+
+// ByAsset groups vaults by their underlying asset, keeping the config order within a group
+func ByAsset(vaults []Vault) map[common.Address][]Vault {
+	groups := make(map[common.Address][]Vault)
+	for _, v := range vaults {
+		groups[v.Asset] = append(groups[v.Asset], v)
+	}
+	return groups
+}
+
+// bindVault binds the Karak vault itself, an ERC-4626 vault whose shares are an ERC20
+func bindVault(provider *ethclient.Client, vault Vault) (*karakvault.KarakVault, error) {
+	bound, err := karakvault.NewKarakVault(vault.Address, provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind vault %s: %v", vault.Name, err)
+	}
+	return bound, nil
+}
+
+// GetVaultShares returns the vault shares held by address
+func GetVaultShares(provider *ethclient.Client, vault Vault, address common.Address) (*big.Int, error) {
+	bound, err := bindVault(provider, vault)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := bound.BalanceOf(&bind.CallOpts{}, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call balanceOf on vault %s: %v", vault.Name, err)
+	}
+	return shares, nil
+}
+
+// ConvertToAssets returns the amount of the underlying asset the given vault shares are worth
+func ConvertToAssets(provider *ethclient.Client, vault Vault, shares *big.Int) (*big.Int, error) {
+	bound, err := bindVault(provider, vault)
+	if err != nil {
+		return nil, err
+	}
+	assets, err := bound.ConvertToAssets(&bind.CallOpts{}, shares)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call convertToAssets on vault %s: %v", vault.Name, err)
+	}
+	return assets, nil
+}
+
+// ConvertToShares returns the vault shares a deposit of assets would mint
+func ConvertToShares(provider *ethclient.Client, vault Vault, assets *big.Int) (*big.Int, error) {
+	bound, err := bindVault(provider, vault)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := bound.ConvertToShares(&bind.CallOpts{}, assets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call convertToShares on vault %s: %v", vault.Name, err)
+	}
+	return shares, nil
+}
+
+// ConvertToAssetsCall is ConvertToAssets as a multicall call, the result is unpacked into assets
+func ConvertToAssetsCall(vault Vault, shares *big.Int, assets **big.Int) (*multicall.Call, error) {
+	vaultABI, err := karakvault.KarakVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vault ABI: %v", err)
+	}
+	return multicall.NewCall(vault.Address, vaultABI, assets, "convertToAssets", shares)
+}
+
+// ValidateAsset checks that the vault's asset() is the asset it is configured with
+func ValidateAsset(provider *ethclient.Client, vault Vault) error {
+	bound, err := bindVault(provider, vault)
+	if err != nil {
+		return err
+	}
+	asset, err := bound.Asset(&bind.CallOpts{})
+	if err != nil {
+		return fmt.Errorf("failed to call asset on vault %s: %v", vault.Name, err)
+	}
+	if asset != vault.Asset {
+		return fmt.Errorf("vault %s holds %s, but is configured with asset %s", vault.Name, asset.Hex(), vault.Asset.Hex())
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package karak
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"puffDep/config"
+	"puffDep/contracts/vaultsupervisor"
+	"puffDep/formatter"
+	"puffDep/signer"
+)
+
+// WithdrawRequest mirrors Withdraw.WithdrawRequest of the VaultSupervisor
+type WithdrawRequest struct {
+	Vaults     []common.Address `json:"vaults"`
+	Shares     []*big.Int       `json:"shares"`
+	Withdrawer common.Address   `json:"withdrawer"`
+}
+
+// QueuedWithdrawal mirrors Withdraw.QueuedWithdrawal, it has to be passed back unchanged to finish the withdrawal
+type QueuedWithdrawal struct {
+	Staker      common.Address  `json:"staker"`
+	DelegatedTo common.Address  `json:"delegatedTo"`
+	Nonce       *big.Int        `json:"nonce"`
+	Start       *big.Int        `json:"start"`
+	Request     WithdrawRequest `json:"request"`
+}
+
+// binding converts the withdrawal to the struct type of the generated VaultSupervisor binding
+func (q *QueuedWithdrawal) binding() vaultsupervisor.WithdrawQueuedWithdrawal {
+	return *abi.ConvertType(*q, new(vaultsupervisor.WithdrawQueuedWithdrawal)).(*vaultsupervisor.WithdrawQueuedWithdrawal)
+}
+
+// Root is the withdrawal root the supervisor keys the queued withdrawal by: keccak256(abi.encode(withdrawal))
+func (q *QueuedWithdrawal) Root() (common.Hash, error) {
+	parsedABI, err := vaultsupervisor.VaultSupervisorMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to parse withdraw ABI: %v", err)
+	}
+	tupleType := parsedABI.Methods["finishWithdraw"].Inputs[0].Type.Elem
+	encoded, err := abi.Arguments{{Type: *tupleType}}.Pack(q)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode withdrawal: %v", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// WithdrawalDelay is how long a queued withdrawal has to wait before it can be finished
+func WithdrawalDelay(cfg *config.Config) time.Duration {
+	return time.Duration(cfg.Karak.WithdrawalDelayHours) * time.Hour
+}
+
+// MaturesAt is when the withdrawal can be finished given the supervisor's withdrawal delay
+func (q *QueuedWithdrawal) MaturesAt(delay time.Duration) time.Time {
+	return time.Unix(q.Start.Int64(), 0).Add(delay)
+}
+
+// StartWithdraw queues a withdrawal of shares from the Karak vault back to the wallet. The queued
+// withdrawal is read by simulating the call first, its start is the timestamp of the block it was mined in.
+func StartWithdraw(provider *ethclient.Client, s signer.Signer, vault Vault, shares *big.Int, cfg *config.Holder) (*formatter.TxResult, *QueuedWithdrawal, error) {
+	supervisor, err := bindSupervisor(provider, vault.Supervisor)
+	if err != nil {
+		return nil, nil, err
+	}
+	fromAddress := s.Address()
+
+	requests := []vaultsupervisor.WithdrawWithdrawRequest{{
+		Vaults:     []common.Address{vault.Address},
+		Shares:     []*big.Int{shares},
+		Withdrawer: fromAddress,
+	}}
+
+	//! Simulate to learn the nonce and operator of the withdrawal
+	var outputs []interface{}
+	raw := &vaultsupervisor.VaultSupervisorRaw{Contract: supervisor}
+	if err := raw.Call(&bind.CallOpts{From: fromAddress}, &outputs, "startWithdraw", requests); err != nil {
+		return nil, nil, fmt.Errorf("startWithdraw simulation failed: %v", err)
+	}
+	configs := *abi.ConvertType(outputs[1], new([]QueuedWithdrawal)).(*[]QueuedWithdrawal)
+	if len(configs) != 1 {
+		return nil, nil, fmt.Errorf("unexpected startWithdraw result with %d withdrawals", len(configs))
+	}
+	queued := configs[0]
+
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := formatter.Transact(provider, s, big.NewInt(0), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return supervisor.StartWithdraw(opts, requests)
+	})
+	if err != nil {
+		return tx, nil, err
+	}
+
+	//! The withdrawal starts at the timestamp of the block it was mined in
+	header, err := provider.HeaderByNumber(context.Background(), tx.Receipt.BlockNumber)
+	if err != nil {
+		return tx, nil, fmt.Errorf("failed to get block of withdrawal: %v", err)
+	}
+	queued.Start = new(big.Int).SetUint64(header.Time)
+
+	return tx, &queued, nil
+}
+
+// FinishWithdraw completes a matured queued withdrawal through the supervisor it was started on
+func FinishWithdraw(provider *ethclient.Client, s signer.Signer, supervisor common.Address, queued *QueuedWithdrawal, cfg *config.Holder) (*formatter.TxResult, error) {
+	bound, err := bindSupervisor(provider, supervisor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, err
+	}
+
+	return formatter.Transact(provider, s, big.NewInt(0), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return bound.FinishWithdraw(opts, []vaultsupervisor.WithdrawQueuedWithdrawal{queued.binding()})
+	})
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Wallet results for WalletsTotal
+const (
+	WalletProcessed = "processed"
+	WalletFailed    = "failed"
+	WalletSkipped   = "skipped"
+)
+
+var (
+	WalletsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "puffdep_wallets_total",
+		Help: "Wallets handled by the runner, by result.",
+	}, []string{"result"})
+
+	TxsSentTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "puffdep_txs_sent_total",
+		Help: "Transactions sent, by pipeline step.",
+	}, []string{"step"})
+
+	TxConfirmationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "puffdep_tx_confirmation_seconds",
+		Help:    "Time from sending a transaction until its receipt is available, by pipeline step.",
+		Buckets: prometheus.ExponentialBuckets(2, 2, 10),
+	}, []string{"step"})
+
+	GasUsedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "puffdep_gas_used_total",
+		Help: "Gas used by mined transactions, by pipeline step.",
+	}, []string{"step"})
+
+	GasSpentEthTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "puffdep_gas_spent_eth_total",
+		Help: "ETH paid for gas by mined transactions, by pipeline step.",
+	}, []string{"step"})
+
+	GasPriceGwei = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "puffdep_gas_price_gwei",
+		Help: "Last gas price seen by the gas price gate.",
+	})
+
+	DelaySecondsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "puffdep_delay_seconds_total",
+		Help: "Time spent waiting in delays, by kind.",
+	}, []string{"kind"})
+
+	LastActivity = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "puffdep_last_activity_timestamp_seconds",
+		Help: "Unix time of the last step the runner finished, for stall alerts.",
+	})
+)
+
+// Touch marks the runner as alive
+func Touch() {
+	LastActivity.Set(float64(time.Now().Unix()))
+}
+
+// Serve exposes /metrics on addr. It blocks, so run it in a goroutine.
+func Serve(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return http.ListenAndServe(addr, mux)
+}
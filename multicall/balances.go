@@ -0,0 +1,147 @@
+package multicall
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"puffDep/contracts/erc20"
+	"puffDep/contracts/multicall3"
+	"puffDep/rpcroute"
+)
+
+// Allowance is an ERC20 allowance of the owner for spender
+type Allowance struct {
+	Token   common.Address
+	Spender common.Address
+}
+
+// Query is what to read for every owner. Karak vault shares are ERC20 balances of the vault.
+type Query struct {
+	Eth        bool
+	Tokens     []common.Address
+	Allowances []Allowance
+}
+
+// Balances is what was read for one owner, Err is the first call of the owner that failed
+type Balances struct {
+	Owner common.Address
+	Eth   *big.Int
+	Err   error
+
+	query      Query
+	tokens     []*big.Int
+	allowances []*big.Int
+}
+
+// Token returns the balance of token, zero if it wasn't read
+func (b *Balances) Token(token common.Address) *big.Int {
+	for i, t := range b.query.Tokens {
+		if t == token && b.tokens[i] != nil {
+			return b.tokens[i]
+		}
+	}
+	return new(big.Int)
+}
+
+// Allowance returns the allowance of token for spender, zero if it wasn't read
+func (b *Balances) Allowance(token common.Address, spender common.Address) *big.Int {
+	for i, a := range b.query.Allowances {
+		if a.Token == token && a.Spender == spender && b.allowances[i] != nil {
+			return b.allowances[i]
+		}
+	}
+	return new(big.Int)
+}
+
+// ReadBalances reads the query for every owner in as few eth_calls as BatchSize allows.
+// An owner whose calls failed is returned with Err set.
+func ReadBalances(client *ethclient.Client, owners []common.Address, q Query) ([]*Balances, error) {
+	erc20ABI, err := erc20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ERC20 ABI: %v", err)
+	}
+	multicallABI, err := multicall3.Multicall3MetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Multicall3 ABI: %v", err)
+	}
+
+	results := make([]*Balances, len(owners))
+	owned := make([][]*Call, len(owners))
+	var calls []*Call
+	for i, owner := range owners {
+		b := &Balances{
+			Owner:      owner,
+			query:      q,
+			tokens:     make([]*big.Int, len(q.Tokens)),
+			allowances: make([]*big.Int, len(q.Allowances)),
+		}
+		results[i] = b
+
+		var ownerCalls []*Call
+		if q.Eth {
+			c, err := NewCall(common.HexToAddress(Multicall3Address), multicallABI, &b.Eth, "getEthBalance", owner)
+			if err != nil {
+				return nil, err
+			}
+			ownerCalls = append(ownerCalls, c)
+		}
+		for j, token := range q.Tokens {
+			c, err := NewCall(token, erc20ABI, &b.tokens[j], "balanceOf", owner)
+			if err != nil {
+				return nil, err
+			}
+			ownerCalls = append(ownerCalls, c)
+		}
+		for j, a := range q.Allowances {
+			c, err := NewCall(a.Token, erc20ABI, &b.allowances[j], "allowance", owner, a.Spender)
+			if err != nil {
+				return nil, err
+			}
+			ownerCalls = append(ownerCalls, c)
+		}
+		owned[i] = ownerCalls
+		calls = append(calls, ownerCalls...)
+	}
+
+	if err := Do(client, calls); err != nil {
+		return nil, err
+	}
+	for i, b := range results {
+		for _, c := range owned[i] {
+			if c.Err != nil {
+				b.Err = c.Err
+				break
+			}
+		}
+	}
+	return results, nil
+}
+
+// ReadBalancesRouted is ReadBalances with the reads of every owner going over its route, routes[i]
+// is the route of owners[i]. Owners on the same route are read in one batch. A route that can't be
+// dialed or read fails only its owners, they are returned with Err set.
+func ReadBalancesRouted(pool *rpcroute.Pool, routes []rpcroute.Route, owners []common.Address, q Query) []*Balances {
+	results := make([]*Balances, len(owners))
+	for _, g := range rpcroute.Groups(routes) {
+		groupOwners := make([]common.Address, len(g.Indexes))
+		for i, index := range g.Indexes {
+			groupOwners[i] = owners[index]
+		}
+
+		client, err := pool.Client(g.Route)
+		var read []*Balances
+		if err == nil {
+			read, err = ReadBalances(client, groupOwners, q)
+		}
+		for i, index := range g.Indexes {
+			if err != nil {
+				results[index] = &Balances{Owner: owners[index], Err: err, query: q, tokens: make([]*big.Int, len(q.Tokens)), allowances: make([]*big.Int, len(q.Allowances))}
+				continue
+			}
+			results[index] = read[i]
+		}
+	}
+	return results
+}
@@ -0,0 +1,82 @@
+package multicall
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"puffDep/contracts/multicall3"
+)
+
+// Multicall3Address is deployed at the same address on nearly every chain
+var Multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+// BatchSize is the number of calls sent in a single aggregate3 eth_call
+var BatchSize = 500
+
+// Call is one read of a batch. Decode gets the return data of a successful call,
+// Err is set when the call reverted or its result couldn't be decoded.
+type Call struct {
+	Target   common.Address
+	CallData []byte
+	Decode   func(returnData []byte) error
+	Err      error
+}
+
+// NewCall packs method of contractABI into a call whose single return value is unpacked into out
+func NewCall(target common.Address, contractABI *abi.ABI, out interface{}, method string, args ...interface{}) (*Call, error) {
+	callData, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s input: %v", method, err)
+	}
+	return &Call{
+		Target:   target,
+		CallData: callData,
+		Decode: func(returnData []byte) error {
+			return contractABI.UnpackIntoInterface(out, method, returnData)
+		},
+	}, nil
+}
+
+// Do runs the calls through Multicall3's aggregate3 in chunks of BatchSize. Each call may fail on
+// its own and gets its Err set, the returned error is for a chunk that couldn't be sent at all.
+func Do(client *ethclient.Client, calls []*Call) error {
+	bound, err := multicall3.NewMulticall3(common.HexToAddress(Multicall3Address), client)
+	if err != nil {
+		return fmt.Errorf("failed to bind Multicall3: %v", err)
+	}
+	raw := &multicall3.Multicall3Raw{Contract: bound}
+
+	for start := 0; start < len(calls); start += BatchSize {
+		end := min(start+BatchSize, len(calls))
+		chunk := calls[start:end]
+
+		requests := make([]multicall3.Multicall3Call3, len(chunk))
+		for i, c := range chunk {
+			requests[i] = multicall3.Multicall3Call3{Target: c.Target, AllowFailure: true, CallData: c.CallData}
+		}
+
+		var outputs []interface{}
+		if err := raw.Call(&bind.CallOpts{}, &outputs, "aggregate3", requests); err != nil {
+			return fmt.Errorf("failed to call aggregate3 with %d calls: %v", len(chunk), err)
+		}
+		results := *abi.ConvertType(outputs[0], new([]multicall3.Multicall3Result)).(*[]multicall3.Multicall3Result)
+		if len(results) != len(chunk) {
+			return fmt.Errorf("aggregate3 returned %d results for %d calls", len(results), len(chunk))
+		}
+
+		for i, result := range results {
+			c := chunk[i]
+			if !result.Success {
+				c.Err = fmt.Errorf("call to %s reverted", c.Target.Hex())
+				continue
+			}
+			if err := c.Decode(result.ReturnData); err != nil {
+				c.Err = fmt.Errorf("failed to decode result of call to %s: %v", c.Target.Hex(), err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"text/template"
+	"time"
+
+	"puffDep/config"
+)
+
+// Event types a target can subscribe to
+const (
+	EventWalletDone = "wallet_done"
+	EventStepFailed = "step_failed"
+	EventGasWait    = "gas_wait"
+	EventRunDone    = "run_done"
+)
+
+// Event is what gets rendered into a message and posted to the targets
+type Event struct {
+	Type      string        `json:"type"`
+	Time      time.Time     `json:"time"`
+	RunID     string        `json:"runId"`
+	Wallet    string        `json:"wallet,omitempty"`
+	Step      string        `json:"step,omitempty"`
+	TxUrl     string        `json:"txUrl,omitempty"`
+	Error     string        `json:"error,omitempty"`
+	Waited    time.Duration `json:"waitedNs,omitempty"`
+	GasPrice  string        `json:"gasPriceGwei,omitempty"`
+	Processed int           `json:"processed,omitempty"`
+	Failed    int           `json:"failed,omitempty"`
+	Skipped   int           `json:"skipped,omitempty"`
+}
+
+var defaultTemplates = map[string]string{
+	EventWalletDone: `✅ {{.Wallet}} finished{{if .TxUrl}}: {{.TxUrl}}{{end}}`,
+	EventStepFailed: `❌ {{.Wallet}} failed at {{.Step}}: {{.Error}}`,
+	EventGasWait:    `⛽ waiting for gas for {{.Waited}}, current price {{.GasPrice}} Gwei`,
+	EventRunDone:    `🏁 run {{.RunID}} done: {{.Processed}} processed, {{.Failed}} failed, {{.Skipped}} skipped`,
+}
+
+// RetryBackoff is the wait before the first retry of a failed notification, it doubles with every retry
+var RetryBackoff = time.Second
+
+// sender delivers a rendered message to one target
+type sender interface {
+	Send(ctx context.Context, event Event, message string) error
+}
+
+type target struct {
+	name      string
+	sender    sender
+	events    map[string]bool
+	templates map[string]*template.Template
+	retries   int
+}
+
+// Dispatcher fans events out to the configured targets in the background.
+// A nil *Dispatcher is valid and drops every event.
+type Dispatcher struct {
+	targets          []*target
+	gasWaitThreshold time.Duration
+	wg               sync.WaitGroup
+
+	mu         sync.Mutex
+	lastWaited time.Duration
+	gasAlerted bool
+}
+
+// New builds a dispatcher from the notify section of the config
+func New(cfg *config.Config) (*Dispatcher, error) {
+	d := &Dispatcher{
+		gasWaitThreshold: time.Duration(cfg.Notify.GasWaitThreshold) * time.Second,
+	}
+
+	for i, t := range cfg.Notify.Targets {
+		var s sender
+		switch t.Type {
+		case "webhook":
+			s = &webhookSender{url: t.Url}
+		case "slack":
+			s = &slackSender{url: t.Url}
+		case "telegram":
+			s = &telegramSender{apiUrl: t.Url, token: t.Token, chatID: t.ChatID}
+		default:
+			return nil, fmt.Errorf("notify.targets[%d]: unknown type %q", i, t.Type)
+		}
+
+		tg := &target{
+			name:      fmt.Sprintf("%s#%d", t.Type, i),
+			sender:    s,
+			events:    make(map[string]bool),
+			templates: make(map[string]*template.Template),
+			retries:   t.Retries,
+		}
+		for _, e := range t.Events {
+			tg.events[e] = true
+		}
+		for event, text := range defaultTemplates {
+			if custom, ok := t.Templates[event]; ok {
+				text = custom
+			}
+			tmpl, err := template.New(event).Parse(text)
+			if err != nil {
+				return nil, fmt.Errorf("notify.targets[%d].templates.%s: %v", i, event, err)
+			}
+			tg.templates[event] = tmpl
+		}
+		d.targets = append(d.targets, tg)
+	}
+	return d, nil
+}
+
+// Notify sends the event to every target subscribed to its type without blocking the caller
+func (d *Dispatcher) Notify(event Event) {
+	if d == nil {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	for _, t := range d.targets {
+		if len(t.events) > 0 && !t.events[event.Type] {
+			continue
+		}
+		var message bytes.Buffer
+		if err := t.templates[event.Type].Execute(&message, event); err != nil {
+			log.Printf("Failed to render %s notification for %s: %v", event.Type, t.name, err)
+			continue
+		}
+
+		d.wg.Add(1)
+		go func(t *target, msg string) {
+			defer d.wg.Done()
+			if err := t.send(event, msg); err != nil {
+				log.Printf("Failed to notify %s: %v", t.name, err)
+			}
+		}(t, message.String())
+	}
+}
+
+// GasWait is meant to be called from formatter.GasWaitHook with the ID of the waiting run. It sends
+// a single gas_wait event per wait once the wait grows past the configured threshold.
+func (d *Dispatcher) GasWait(runID string, waited time.Duration, gasPriceGwei string) {
+	if d == nil || d.gasWaitThreshold <= 0 {
+		return
+	}
+
+	d.mu.Lock()
+	// waits only grow within one gas gate, a smaller value means a new gate started
+	if waited <= d.lastWaited {
+		d.gasAlerted = false
+	}
+	d.lastWaited = waited
+	fire := waited >= d.gasWaitThreshold && !d.gasAlerted
+	if fire {
+		d.gasAlerted = true
+	}
+	d.mu.Unlock()
+
+	if fire {
+		d.Notify(Event{Type: EventGasWait, RunID: runID, Waited: waited, GasPrice: gasPriceGwei})
+	}
+}
+
+// Close waits for the notifications still in flight
+func (d *Dispatcher) Close() {
+	if d == nil {
+		return
+	}
+	d.wg.Wait()
+}
+
+// send delivers the message, retrying with exponential backoff
+func (t *target) send(event Event, message string) error {
+	var err error
+	backoff := RetryBackoff
+	for attempt := 0; attempt <= t.retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err = t.sender.Send(ctx, event, message)
+		cancel()
+		if err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("giving up after %d attempts: %v", t.retries+1, err)
+}
@@ -0,0 +1,324 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"puffDep/config"
+)
+
+// stub is a local stand-in for a notification endpoint, it records every request and answers
+// with the next status of failures before it starts returning 200
+type stub struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	paths    []string
+	bodies   []map[string]interface{}
+	failures int
+}
+
+func newStub(t *testing.T, failures int) *stub {
+	s := &stub{failures: failures}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		var body map[string]interface{}
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("request body is not json: %s", data)
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.paths = append(s.paths, r.URL.Path)
+		s.bodies = append(s.bodies, body)
+		if s.failures > 0 {
+			s.failures--
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *stub) requests() ([]string, []map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.paths...), append([]map[string]interface{}(nil), s.bodies...)
+}
+
+// newDispatcher loads the notify section from yaml, the way the config file is read
+func newDispatcher(t *testing.T, notify string) *Dispatcher {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("notify:\n"+notify), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestWebhookPayload(t *testing.T) {
+	s := newStub(t, 0)
+	d := newDispatcher(t, `
+  targets:
+    - type: webhook
+      url: `+s.URL+`
+`)
+	d.Notify(Event{Type: EventStepFailed, RunID: "run1", Wallet: "0xabc", Step: "approve", Error: "reverted"})
+	d.Close()
+
+	_, bodies := s.requests()
+	if len(bodies) != 1 {
+		t.Fatalf("got %d requests, want 1", len(bodies))
+	}
+	body := bodies[0]
+	for field, want := range map[string]string{
+		"type":    EventStepFailed,
+		"runId":   "run1",
+		"wallet":  "0xabc",
+		"step":    "approve",
+		"error":   "reverted",
+		"message": "❌ 0xabc failed at approve: reverted",
+	} {
+		if body[field] != want {
+			t.Errorf("%s = %v, want %q", field, body[field], want)
+		}
+	}
+	if _, ok := body["time"]; !ok {
+		t.Errorf("payload has no time")
+	}
+}
+
+func TestSlackPayload(t *testing.T) {
+	s := newStub(t, 0)
+	d := newDispatcher(t, `
+  targets:
+    - type: slack
+      url: `+s.URL+`
+`)
+	d.Notify(Event{Type: EventRunDone, RunID: "run1", Processed: 3, Failed: 1, Skipped: 2})
+	d.Close()
+
+	_, bodies := s.requests()
+	if len(bodies) != 1 {
+		t.Fatalf("got %d requests, want 1", len(bodies))
+	}
+	want := map[string]interface{}{"text": "🏁 run run1 done: 3 processed, 1 failed, 2 skipped"}
+	if len(bodies[0]) != 1 || bodies[0]["text"] != want["text"] {
+		t.Errorf("payload = %v, want %v", bodies[0], want)
+	}
+}
+
+func TestTelegramPayload(t *testing.T) {
+	s := newStub(t, 0)
+	d := newDispatcher(t, `
+  targets:
+    - type: telegram
+      url: `+s.URL+`/
+      token: "123:secret"
+      chatId: "-100"
+`)
+	d.Notify(Event{Type: EventWalletDone, Wallet: "0xabc", TxUrl: "https://etherscan.io/tx/0x1"})
+	d.Close()
+
+	paths, bodies := s.requests()
+	if len(bodies) != 1 {
+		t.Fatalf("got %d requests, want 1", len(bodies))
+	}
+	if paths[0] != "/bot123:secret/sendMessage" {
+		t.Errorf("path = %s", paths[0])
+	}
+	if bodies[0]["chat_id"] != "-100" || bodies[0]["text"] != "✅ 0xabc finished: https://etherscan.io/tx/0x1" {
+		t.Errorf("payload = %v", bodies[0])
+	}
+}
+
+func TestTelegramRedactsToken(t *testing.T) {
+	s := httptest.NewServer(http.NotFoundHandler())
+	url := s.URL
+	s.Close()
+
+	sender := &telegramSender{apiUrl: url, token: "123:secret", chatID: "-100"}
+	err := sender.Send(context.Background(), Event{}, "hello")
+	if err == nil {
+		t.Fatal("expected an error from a closed server")
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("error leaks the token: %v", err)
+	}
+	if !strings.Contains(err.Error(), "/bot***/sendMessage") {
+		t.Errorf("error doesn't show the redacted url: %v", err)
+	}
+}
+
+func TestCustomTemplates(t *testing.T) {
+	s := newStub(t, 0)
+	d := newDispatcher(t, `
+  targets:
+    - type: slack
+      url: `+s.URL+`
+      events: [step_failed]
+      templates:
+        step_failed: "{{.Step}} of {{.Wallet}} in {{.RunID}}"
+`)
+	d.Notify(Event{Type: EventWalletDone, Wallet: "0xabc"})
+	d.Notify(Event{Type: EventStepFailed, RunID: "run1", Wallet: "0xabc", Step: "deposit-karak"})
+	d.Close()
+
+	_, bodies := s.requests()
+	if len(bodies) != 1 {
+		t.Fatalf("got %d requests, want only the subscribed event", len(bodies))
+	}
+	if bodies[0]["text"] != "deposit-karak of 0xabc in run1" {
+		t.Errorf("text = %v", bodies[0]["text"])
+	}
+}
+
+func TestInvalidTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	yaml := "notify:\n  targets:\n    - type: slack\n      url: http://localhost\n      templates:\n        run_done: \"{{.RunID\"\n"
+	if err := os.WriteFile(path, []byte(yaml), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(cfg); err == nil || !strings.Contains(err.Error(), "notify.targets[0].templates.run_done") {
+		t.Errorf("err = %v, want the template path", err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	backoff := RetryBackoff
+	RetryBackoff = 10 * time.Millisecond
+	t.Cleanup(func() { RetryBackoff = backoff })
+
+	s := newStub(t, 2)
+	d := newDispatcher(t, `
+  targets:
+    - type: slack
+      url: `+s.URL+`
+      retries: 2
+`)
+	start := time.Now()
+	d.Notify(Event{Type: EventRunDone, RunID: "run1"})
+	d.Close()
+
+	_, bodies := s.requests()
+	if len(bodies) != 3 {
+		t.Fatalf("got %d attempts, want 3", len(bodies))
+	}
+	// 10ms before the first retry, doubled to 20ms before the second
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("retries took %s, want at least 30ms of backoff", elapsed)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	backoff := RetryBackoff
+	RetryBackoff = time.Millisecond
+	t.Cleanup(func() { RetryBackoff = backoff })
+
+	s := newStub(t, 5)
+	tg := &target{name: "slack#0", sender: &slackSender{url: s.URL}, retries: 1}
+	err := tg.send(Event{}, "hello")
+	if err == nil || !strings.Contains(err.Error(), "giving up after 2 attempts") {
+		t.Errorf("err = %v", err)
+	}
+	if _, bodies := s.requests(); len(bodies) != 2 {
+		t.Errorf("got %d attempts, want 2", len(bodies))
+	}
+}
+
+func TestGasWaitOncePerWait(t *testing.T) {
+	s := newStub(t, 0)
+	d := newDispatcher(t, `
+  gasWaitThreshold: 60
+  targets:
+    - type: slack
+      url: `+s.URL+`
+`)
+	// first gate: below the threshold, then past it for several polls
+	for _, waited := range []time.Duration{30 * time.Second, 60 * time.Second, 90 * time.Second, 120 * time.Second} {
+		d.GasWait("run1", waited, "25.00")
+	}
+	// second gate starts over and crosses the threshold again
+	for _, waited := range []time.Duration{10 * time.Second, 70 * time.Second, 80 * time.Second} {
+		d.GasWait("run1", waited, "30.00")
+	}
+	d.Close()
+
+	_, bodies := s.requests()
+	if len(bodies) != 2 {
+		t.Fatalf("got %d gas_wait notifications, want one per wait", len(bodies))
+	}
+	// targets are notified in the background, the two may arrive in any order
+	texts := map[interface{}]bool{bodies[0]["text"]: true, bodies[1]["text"]: true}
+	for _, want := range []string{
+		"⛽ waiting for gas for 1m0s, current price 25.00 Gwei",
+		"⛽ waiting for gas for 1m10s, current price 30.00 Gwei",
+	} {
+		if !texts[want] {
+			t.Errorf("missing %q in %v", want, texts)
+		}
+	}
+}
+
+func TestGasWaitRunID(t *testing.T) {
+	s := newStub(t, 0)
+	d := newDispatcher(t, `
+  gasWaitThreshold: 60
+  targets:
+    - type: webhook
+      url: `+s.URL+`
+`)
+	d.GasWait("run1", 90*time.Second, "25.00")
+	d.Close()
+
+	_, bodies := s.requests()
+	if len(bodies) != 1 {
+		t.Fatalf("got %d requests, want 1", len(bodies))
+	}
+	if bodies[0]["type"] != EventGasWait || bodies[0]["runId"] != "run1" {
+		t.Errorf("payload = %v, want a gas_wait of run1", bodies[0])
+	}
+}
+
+func TestGasWaitDisabled(t *testing.T) {
+	s := newStub(t, 0)
+	d := newDispatcher(t, `
+  targets:
+    - type: slack
+      url: `+s.URL+`
+`)
+	d.GasWait("run1", time.Hour, "25.00")
+	d.Close()
+
+	if _, bodies := s.requests(); len(bodies) != 0 {
+		t.Errorf("got %d notifications without a threshold", len(bodies))
+	}
+}
+
+func TestNilDispatcher(t *testing.T) {
+	var d *Dispatcher
+	d.Notify(Event{Type: EventRunDone})
+	d.GasWait("run1", time.Hour, "1")
+	d.Close()
+}
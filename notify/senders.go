@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+func postJSON(ctx context.Context, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("responded %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
+
+// webhookSender posts the whole event plus the rendered message as json
+type webhookSender struct {
+	url string
+}
+
+func (s *webhookSender) Send(ctx context.Context, event Event, message string) error {
+	return postJSON(ctx, s.url, struct {
+		Event
+		Message string `json:"message"`
+	}{event, message})
+}
+
+// slackSender posts to a Slack compatible incoming webhook
+type slackSender struct {
+	url string
+}
+
+func (s *slackSender) Send(ctx context.Context, event Event, message string) error {
+	return postJSON(ctx, s.url, map[string]string{"text": message})
+}
+
+// telegramSender calls sendMessage of the Telegram bot API. apiUrl defaults to the public
+// API and can point to a local stand-in.
+type telegramSender struct {
+	apiUrl string
+	token  string
+	chatID string
+}
+
+func (s *telegramSender) Send(ctx context.Context, event Event, message string) error {
+	apiUrl := s.apiUrl
+	if apiUrl == "" {
+		apiUrl = "https://api.telegram.org"
+	}
+	url := fmt.Sprintf("%s/bot%s/sendMessage", strings.TrimRight(apiUrl, "/"), s.token)
+	err := postJSON(ctx, url, map[string]string{"chat_id": s.chatID, "text": message})
+	if err != nil && s.token != "" {
+		// transport errors quote the url, keep the bot token out of the logs
+		return fmt.Errorf("%s", strings.ReplaceAll(err.Error(), s.token, "***"))
+	}
+	return err
+}
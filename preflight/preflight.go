@@ -0,0 +1,139 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/fatih/color"
+	"puffDep/config"
+	"puffDep/contracts/puffervault"
+	"puffDep/karak"
+	"puffDep/puff"
+)
+
+var (
+	okText   = color.New(color.FgGreen)
+	failText = color.New(color.FgRed)
+)
+
+// Check is one sanity check, Err is nil when it passed
+type Check struct {
+	Name string
+	Err  error
+}
+
+// Report is the outcome of all checks
+type Report struct {
+	Checks []Check
+}
+
+func (r *Report) add(name string, err error) {
+	r.Checks = append(r.Checks, Check{Name: name, Err: err})
+}
+
+// Failed returns the checks that did not pass
+func (r *Report) Failed() []Check {
+	var failed []Check
+	for _, c := range r.Checks {
+		if c.Err != nil {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// Print writes one line per check
+func (r *Report) Print() {
+	for _, c := range r.Checks {
+		if c.Err != nil {
+			failText.Printf("FAIL %s: %v\n", c.Name, c.Err)
+		} else {
+			okText.Printf("OK   %s\n", c.Name)
+		}
+	}
+}
+
+// Err summarizes the failed checks, nil when everything passed
+func (r *Report) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("preflight failed %d of %d checks, first: %s: %v", len(failed), len(r.Checks), failed[0].Name, failed[0].Err)
+}
+
+// Run checks that the node is on the selected network, that the Puffer vault, the Karak
+// supervisors and vaults have code, that puffETH reports the expected name and that every
+// Karak vault holds the asset it is configured with. It runs every check and does not stop at the first failure.
+func Run(client *ethclient.Client, cfg *config.Config) (*Report, error) {
+	n, err := cfg.SelectedNetwork()
+	if err != nil {
+		return nil, err
+	}
+	vaults, err := karak.Vaults(cfg)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	report := &Report{}
+
+	//! Chain ID
+	chainID, err := client.ChainID(ctx)
+	if err == nil && chainID.Uint64() != n.ChainID {
+		err = fmt.Errorf("node is on chain %s, network %q expects chain %d", chainID, cfg.Network, n.ChainID)
+	}
+	report.add("chain id", err)
+
+	//! Contract code
+	puffEth := common.HexToAddress(puff.EthPuffTokenContractAddress)
+	report.add("code at puffer vault "+puffEth.Hex(), hasCode(client, puffEth))
+	seen := map[common.Address]bool{}
+	for _, v := range vaults {
+		if !seen[v.Supervisor] {
+			seen[v.Supervisor] = true
+			report.add("code at karak supervisor "+v.Supervisor.Hex(), hasCode(client, v.Supervisor))
+		}
+		report.add(fmt.Sprintf("code at karak vault %s (%s)", v.Name, v.Address.Hex()), hasCode(client, v.Address))
+	}
+
+	//! puffETH name
+	report.add("puffETH name", checkName(client, puffEth, n.PuffEthName))
+
+	//! Vault assets
+	for _, v := range vaults {
+		report.add(fmt.Sprintf("asset of karak vault %s", v.Name), karak.ValidateAsset(client, v))
+	}
+	return report, nil
+}
+
+func hasCode(client *ethclient.Client, address common.Address) error {
+	code, err := client.CodeAt(context.Background(), address, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get code: %v", err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("no contract deployed at %s", address.Hex())
+	}
+	return nil
+}
+
+func checkName(client *ethclient.Client, puffEth common.Address, expected string) error {
+	vault, err := puffervault.NewPufferVault(puffEth, client)
+	if err != nil {
+		return fmt.Errorf("failed to bind puffer vault: %v", err)
+	}
+	name, err := vault.Name(&bind.CallOpts{})
+	if err != nil {
+		return fmt.Errorf("failed to call name: %v", err)
+	}
+	if expected != "" && name != expected {
+		return fmt.Errorf("token at %s is named %q, expected %q", puffEth.Hex(), name, expected)
+	}
+	if name == "" {
+		return fmt.Errorf("token at %s has an empty name", puffEth.Hex())
+	}
+	return nil
+}
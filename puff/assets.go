@@ -0,0 +1,127 @@
+package puff
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"puffDep/config"
+	"puffDep/contracts/erc20"
+	"puffDep/contracts/steth"
+	"puffDep/formatter"
+	"puffDep/signer"
+)
+
+// Assets the Puffer vault accepts
+const (
+	AssetEth   = "eth"
+	AssetWeth  = "weth"
+	AssetStEth = "steth"
+)
+
+var StEthContractAddress = "0xae7ab96520DE3A18E5e111B5EaAb095312D7fE84"
+
+// TokenAddress returns the token contract of a vault asset, ETH has none
+func TokenAddress(asset string) (string, error) {
+	switch asset {
+	case AssetWeth:
+		return WethContractAddress, nil
+	case AssetStEth:
+		return StEthContractAddress, nil
+	}
+	return "", fmt.Errorf("asset %q is not a token", asset)
+}
+
+func bindToken(provider *ethclient.Client, token string) (*erc20.ERC20, error) {
+	bound, err := erc20.NewERC20(common.HexToAddress(token), provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind token %s: %v", token, err)
+	}
+	return bound, nil
+}
+
+func GetTokenBalance(provider *ethclient.Client, token string, address common.Address) (*big.Int, error) {
+	bound, err := bindToken(provider, token)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := bound.BalanceOf(&bind.CallOpts{}, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call balanceOf on %s: %v", token, err)
+	}
+	return balance, nil
+}
+
+func GetTokenAllowance(provider *ethclient.Client, token string, owner common.Address, spender string) (*big.Int, error) {
+	bound, err := bindToken(provider, token)
+	if err != nil {
+		return nil, err
+	}
+	allowance, err := bound.Allowance(&bind.CallOpts{}, owner, common.HexToAddress(spender))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call allowance on %s: %v", token, err)
+	}
+	return allowance, nil
+}
+
+// ApproveToken approves amount of an ERC20 token for spender
+func ApproveToken(provider *ethclient.Client, s signer.Signer, token string, spender string, amount *big.Int, cfg *config.Holder) (*formatter.TxResult, error) {
+	bound, err := bindToken(provider, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, err
+	}
+
+	return formatter.Transact(provider, s, big.NewInt(0), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return bound.Approve(opts, common.HexToAddress(spender), amount)
+	})
+}
+
+// DepositWeth deposits WETH through the vault's ERC-4626 deposit, the vault needs an allowance for amount.
+// The puffETH is minted to receiver.
+func DepositWeth(provider *ethclient.Client, s signer.Signer, amount *big.Int, receiver common.Address, cfg *config.Holder) (*formatter.TxResult, error) {
+	vault, err := pufferVault(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, err
+	}
+
+	return formatter.Transact(provider, s, big.NewInt(0), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return vault.Deposit(opts, amount, receiver)
+	})
+}
+
+// DepositStEth deposits amount of stETH. The vault takes stETH shares, so the amount is
+// converted first, and it needs an allowance for amount. The puffETH is minted to receiver.
+func DepositStEth(provider *ethclient.Client, s signer.Signer, amount *big.Int, receiver common.Address, cfg *config.Holder) (*formatter.TxResult, error) {
+	vault, err := pufferVault(provider)
+	if err != nil {
+		return nil, err
+	}
+	stEth, err := steth.NewStETH(common.HexToAddress(StEthContractAddress), provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind stETH: %v", err)
+	}
+
+	stEthShares, err := stEth.GetSharesByPooledEth(&bind.CallOpts{}, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call getSharesByPooledEth: %v", err)
+	}
+
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, err
+	}
+
+	return formatter.Transact(provider, s, big.NewInt(0), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return vault.DepositStETH(opts, stEthShares, receiver)
+	})
+}
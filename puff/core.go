@@ -1,260 +1,85 @@
 package puff
 
 import (
-	"context"
-	"crypto/ecdsa"
 	"fmt"
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/fatih/color"
-	"log"
 	"math/big"
 	"puffDep/config"
+	"puffDep/contracts/puffervault"
 	"puffDep/formatter"
-	"strings"
+	"puffDep/multicall"
+	"puffDep/signer"
 )
 
 var EthPuffTokenContractAddress = "0xD9A442856C234a39a81a089C06451EBAa4306a72"
-var contractABI = `[{"inputs":[{"internalType":"contract IStETH","name":"stETH","type":"address"},{"internalType":"contract IWETH","name":"weth","type":"address"},{"internalType":"contract ILidoWithdrawalQueue","name":"lidoWithdrawalQueue","type":"address"},{"internalType":"contract IStrategy","name":"stETHStrategy","type":"address"},{"internalType":"contract IEigenLayer","name":"eigenStrategyManager","type":"address"},{"internalType":"contract IPufferOracle","name":"oracle","type":"address"},{"internalType":"contract IDelegationManager","name":"delegationManager","type":"address"}],"stateMutability":"nonpayable","type":"constructor"},{"inputs":[],"name":"name","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"receiver","type":"address"}],"name":"depositETH","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"address","name":"spender","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"}],"name":"approve","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"balanceOf","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
 var InfoText = color.New(color.FgBlue)
 
-func DepositEth(provider *ethclient.Client, privateKeyECDSA *ecdsa.PrivateKey, amountInEth float64, cfg *config.Config) string {
-
-	ctx := context.Background()
-
-	contractAddress := common.HexToAddress(EthPuffTokenContractAddress)
-
-	fromAddress := crypto.PubkeyToAddress(privateKeyECDSA.PublicKey)
-
-	//! Parsed ABI
-	parsedABI, err := abi.JSON(strings.NewReader(contractABI))
-	if err != nil {
-		log.Printf("Failed to parse contract ABI: %v", err)
-	}
-
-	//! Get the nonce
-	nonce, err := provider.PendingNonceAt(ctx, fromAddress)
-	if err != nil {
-		log.Printf("Failed to get nonce: %v", err)
-	}
-
-	//! Gas Price
-	gasPrice, err := provider.SuggestGasPrice(context.Background())
-	if err != nil {
-		log.Printf("Failed to get gas price: %v", err)
-	}
-
-	// ! Chain ID
-	chainID, err := provider.ChainID(context.Background())
-	if err != nil {
-		log.Printf("Failed to get chain ID: %v", err)
-	}
-
-	// ! Calldata
-	callData, err := parsedABI.Pack("depositETH", fromAddress)
-	if err != nil {
-		log.Printf("Failed to pack function input: %v", err)
-	}
-
-	//! Convert ETH amount to Wei
-	valueInWei := formatter.ConvertEtherToWei(amountInEth)
-
-	formatter.CheckGasPrice(provider, cfg)
-
-	// ! GasLimit
-	gasLimit, err := provider.EstimateGas(context.Background(), ethereum.CallMsg{
-		To:    &contractAddress,
-		Data:  callData,
-		Value: valueInWei,
-	})
-	if err != nil {
-		log.Printf("Failed to estimate gas: %v", err)
-	}
-
-	//! Get wallet balance
-	walletBalance, err := provider.BalanceAt(ctx, fromAddress, nil)
-	if err != nil {
-		log.Printf("Failed to get wallet balance: %v", err)
-	}
-
-	//! Check if the wallet has enough balance
-	transactionPrice, hasEnoughBalance := formatter.GetTransactionCost(gasLimit, gasPrice, valueInWei, walletBalance)
-	if !hasEnoughBalance {
-		log.Printf("Insufficient balance. Transaction cost: %v", transactionPrice)
-	}
-
-	//!Data for function
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKeyECDSA, chainID)
-	if err != nil {
-		log.Printf("Failed to create keyed transactor %v", err)
-	}
-
-	auth.Nonce = big.NewInt(int64(nonce))
-	auth.Value = valueInWei
-	auth.GasLimit = gasLimit
-	auth.GasPrice = gasPrice
-
-	//! Construct the transaction
-	tx := types.NewTx(&types.DynamicFeeTx{
-		ChainID:   chainID,
-		Nonce:     nonce,
-		GasTipCap: gasPrice,
-		GasFeeCap: gasPrice,
-		Gas:       auth.GasLimit,
-		To:        &contractAddress,
-		Value:     auth.Value,
-		Data:      callData,
-	})
-
-	//! Sign the transaction
-	signedTx, err := auth.Signer(fromAddress, tx)
-	if err != nil {
-		log.Printf("Failed to sign transaction: %v", err)
-	}
-
-	//! Send the transaction
-	err = provider.SendTransaction(context.Background(), signedTx)
-	if err != nil {
-		log.Printf("Failed to send transaction: %v", err)
-	}
-
-	InfoText.Printf("Transaction sent: %s\n", signedTx.Hash().Hex())
-
-	receipt, err := formatter.WaitForTransactionReceipt(provider, signedTx.Hash())
+// pufferVault binds the Puffer vault, which is also the puffETH token
+func pufferVault(provider *ethclient.Client) (*puffervault.PufferVault, error) {
+	vault, err := puffervault.NewPufferVault(common.HexToAddress(EthPuffTokenContractAddress), provider)
 	if err != nil {
-		log.Printf("Failed to get transaction receipt: %v", err)
+		return nil, fmt.Errorf("failed to bind Puffer vault: %v", err)
 	}
-
-	fmt.Printf("Transaction confirmed in block: %d\n", receipt.BlockNumber.Uint64())
-
-	return "https://etherscan.io/tx/" + signedTx.Hash().Hex()
+	return vault, nil
 }
 
-func ApprovePuffEth(provider *ethclient.Client, privateKeyECDSA *ecdsa.PrivateKey, amountPuffEth *big.Int, spender string) string {
-
-	ctx := context.Background()
-
-	fromAddress := crypto.PubkeyToAddress(privateKeyECDSA.PublicKey)
-
-	contractAddress := common.HexToAddress(EthPuffTokenContractAddress)
-
-	//! Get the nonce
-	nonce, err := provider.PendingNonceAt(ctx, fromAddress)
-	if err != nil {
-		log.Printf("Failed to get nonce: %v", err)
-	}
-
-	//! Gas Price
-	gasPrice, err := provider.SuggestGasPrice(context.Background())
-	if err != nil {
-		log.Printf("Failed to get gas price: %v", err)
-	}
-
-	// ! Chain ID
-	chainID, err := provider.ChainID(context.Background())
-	if err != nil {
-		log.Printf("Failed to get chain ID: %v", err)
-	}
-
-	//! Parsed ABI
-	parsedABI, err := abi.JSON(strings.NewReader(contractABI))
-	if err != nil {
-		log.Printf("Failed to parse contract ABI: %v", err)
-	}
-
-	// ! Calldata
-	callData, err := parsedABI.Pack("approve", common.HexToAddress(spender), amountPuffEth)
-	if err != nil {
-		log.Printf("Failed to pack function input: %v", err)
-	}
-
-	// ! GasLimit
-	gasLimit, err := provider.EstimateGas(context.Background(), ethereum.CallMsg{
-		To:   &contractAddress,
-		From: fromAddress,
-		Data: callData,
-	})
+// DepositEth deposits valueInWei of ETH, the puffETH is minted to receiver
+func DepositEth(provider *ethclient.Client, s signer.Signer, valueInWei *big.Int, receiver common.Address, cfg *config.Holder) (*formatter.TxResult, error) {
+	vault, err := pufferVault(provider)
 	if err != nil {
-		log.Printf("Failed to estimate gas: %v", err)
+		return nil, err
 	}
 
-	//!Data for function
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKeyECDSA, chainID)
-	if err != nil {
-		log.Printf("Failed to create keyed transactor %v", err)
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, err
 	}
 
-	auth.Nonce = big.NewInt(int64(nonce))
-	auth.Value = big.NewInt(0)
-	auth.GasLimit = gasLimit
-	auth.GasPrice = gasPrice
-
-	//! Construct the transaction
-	tx := types.NewTx(&types.DynamicFeeTx{
-		ChainID:   chainID,
-		Nonce:     nonce,
-		GasTipCap: gasPrice,
-		GasFeeCap: gasPrice,
-		Gas:       auth.GasLimit,
-		To:        &contractAddress,
-		Value:     auth.Value,
-		Data:      callData,
+	return formatter.Transact(provider, s, valueInWei, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return vault.DepositETH(opts, receiver)
 	})
+}
 
-	//! Sign the transaction
-	signedTx, err := auth.Signer(fromAddress, tx)
+func GetPuffEthBalance(provider *ethclient.Client, address common.Address) (*big.Int, error) {
+	balances, err := GetPuffEthBalances(provider, []common.Address{address})
 	if err != nil {
-		log.Printf("Failed to sign transaction: %v", err)
+		return nil, err
 	}
+	return balances[0], nil
+}
 
-	//! Send the transaction
-	err = provider.SendTransaction(context.Background(), signedTx)
+// GetPuffEthBalances reads the puffETH balance of every address in batched multicalls
+func GetPuffEthBalances(provider *ethclient.Client, addresses []common.Address) ([]*big.Int, error) {
+	puffEth := common.HexToAddress(EthPuffTokenContractAddress)
+	read, err := multicall.ReadBalances(provider, addresses, multicall.Query{Tokens: []common.Address{puffEth}})
 	if err != nil {
-		log.Printf("Failed to send transaction: %v", err)
+		return nil, err
 	}
-
-	InfoText.Printf("Transaction sent: %s\n", signedTx.Hash().Hex())
-
-	receipt, err := formatter.WaitForTransactionReceipt(provider, signedTx.Hash())
-	if err != nil {
-		log.Printf("Failed to get transaction receipt: %v", err)
+	balances := make([]*big.Int, len(read))
+	for i, b := range read {
+		if b.Err != nil {
+			return nil, fmt.Errorf("failed to get puffETH balance of %s: %v", b.Owner.Hex(), b.Err)
+		}
+		balances[i] = b.Token(puffEth)
 	}
-
-	fmt.Printf("Transaction confirmed in block: %d\n", receipt.BlockNumber.Uint64())
-
-	return "https://etherscan.io/tx/" + signedTx.Hash().Hex()
-
+	return balances, nil
 }
 
-func GetPuffEthBalance(provider *ethclient.Client, address common.Address) (*big.Int, error) {
-	contractAddress := common.HexToAddress(EthPuffTokenContractAddress)
-	//! Parsed ABI
-	parsedABI, err := abi.JSON(strings.NewReader(contractABI))
+// TransferPuffEth sends amount of puffETH to `to`
+func TransferPuffEth(provider *ethclient.Client, s signer.Signer, to common.Address, amount *big.Int, cfg *config.Holder) (*formatter.TxResult, error) {
+	vault, err := pufferVault(provider)
 	if err != nil {
-		log.Printf("Failed to parse contract ABI: %v", err)
-	}
-
-	callData, err := parsedABI.Pack("balanceOf", address)
-
-	msg := ethereum.CallMsg{
-		To:   &contractAddress,
-		Data: callData,
+		return nil, err
 	}
 
-	result, err := provider.CallContract(context.Background(), msg, nil)
-	if err != nil {
-		log.Printf("Failed to call contract: %v", err)
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, err
 	}
 
-	var balance *big.Int
-	err = parsedABI.UnpackIntoInterface(&balance, "balanceOf", result)
-	if err != nil {
-		log.Printf("Failed to unpack result: %v", err)
-	}
-	return balance, nil
+	return formatter.Transact(provider, s, big.NewInt(0), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return vault.Transfer(opts, to, amount)
+	})
 }
@@ -0,0 +1,88 @@
+package puff
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"puffDep/config"
+	"puffDep/contracts/weth"
+	"puffDep/formatter"
+	"puffDep/signer"
+)
+
+// WethContractAddress is what the Puffer vault pays redemptions out in
+var WethContractAddress = "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
+
+// RedeemPreview is what redeeming a number of puffETH shares would give right now
+type RedeemPreview struct {
+	Shares             *big.Int
+	MaxRedeem          *big.Int
+	AssetsOut          *big.Int
+	ExitFeeBasisPoints *big.Int
+	DailyLimitLeft     *big.Int
+}
+
+// PreviewRedeem reads the vault's redemption limits for owner and the WETH out for shares, net of the exit fee
+func PreviewRedeem(provider *ethclient.Client, owner common.Address, shares *big.Int) (*RedeemPreview, error) {
+	vault, err := pufferVault(provider)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{}
+	preview := &RedeemPreview{Shares: shares}
+
+	if preview.MaxRedeem, err = vault.MaxRedeem(opts, owner); err != nil {
+		return nil, fmt.Errorf("failed to call maxRedeem: %v", err)
+	}
+	if preview.AssetsOut, err = vault.PreviewRedeem(opts, shares); err != nil {
+		return nil, fmt.Errorf("failed to call previewRedeem: %v", err)
+	}
+	if preview.ExitFeeBasisPoints, err = vault.GetExitFeeBasisPoints(opts); err != nil {
+		return nil, fmt.Errorf("failed to call getExitFeeBasisPoints: %v", err)
+	}
+	if preview.DailyLimitLeft, err = vault.GetRemainingAssetsDailyWithdrawalLimit(opts); err != nil {
+		return nil, fmt.Errorf("failed to call getRemainingAssetsDailyWithdrawalLimit: %v", err)
+	}
+	return preview, nil
+}
+
+// RedeemPuffEth redeems puffETH shares for WETH paid to the wallet itself
+func RedeemPuffEth(provider *ethclient.Client, s signer.Signer, shares *big.Int, cfg *config.Holder) (*formatter.TxResult, error) {
+	vault, err := pufferVault(provider)
+	if err != nil {
+		return nil, err
+	}
+	fromAddress := s.Address()
+
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, err
+	}
+
+	return formatter.Transact(provider, s, big.NewInt(0), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return vault.Redeem(opts, shares, fromAddress, fromAddress)
+	})
+}
+
+func GetWethBalance(provider *ethclient.Client, address common.Address) (*big.Int, error) {
+	return GetTokenBalance(provider, WethContractAddress, address)
+}
+
+// UnwrapWeth turns amount of WETH back into ETH
+func UnwrapWeth(provider *ethclient.Client, s signer.Signer, amount *big.Int, cfg *config.Holder) (*formatter.TxResult, error) {
+	token, err := weth.NewWETH(common.HexToAddress(WethContractAddress), provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind WETH: %v", err)
+	}
+
+	if err := formatter.CheckGasPrice(provider, cfg); err != nil {
+		return nil, err
+	}
+
+	return formatter.Transact(provider, s, big.NewInt(0), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.Withdraw(opts, amount)
+	})
+}
@@ -0,0 +1,218 @@
+package relay
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/fatih/color"
+	"puffDep/config"
+	"puffDep/rpcroute"
+)
+
+var (
+	infoText    = color.New(color.FgBlue)
+	warningText = color.New(color.FgYellow)
+)
+
+const (
+	MethodPrivateTransaction = "eth_sendPrivateTransaction"
+	MethodBundle             = "eth_sendBundle"
+)
+
+// PollInterval is how often the block number is checked while waiting for inclusion
+var PollInterval = 2 * time.Second
+
+// Relay sends signed transactions to a private relay and falls back to the public mempool
+// when they are not included within FallbackBlocks
+type Relay struct {
+	url            string
+	method         string
+	authKey        *ecdsa.PrivateKey
+	fallbackBlocks uint64
+	client         *http.Client
+	id             atomic.Uint64
+}
+
+// New builds the relay of the config, nil when relay.url is not set
+func New(cfg *config.Config) (*Relay, error) {
+	if cfg.Relay.Url == "" {
+		return nil, nil
+	}
+
+	var authKey *ecdsa.PrivateKey
+	var err error
+	if cfg.Relay.AuthKey != "" {
+		authKey, err = crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(cfg.Relay.AuthKey), "0x"))
+	} else {
+		authKey, err = crypto.GenerateKey()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load relay.authKey: %v", err)
+	}
+
+	return &Relay{
+		url:            cfg.Relay.Url,
+		method:         cfg.Relay.Method,
+		authKey:        authKey,
+		fallbackBlocks: cfg.Relay.FallbackBlocks,
+		client:         &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Submit sends the signed transaction to the relay and waits until it is included. A bundle is resent
+// for every new block. When the relay rejects the transaction, or it isn't included after
+// FallbackBlocks, it is sent to the public mempool through provider.
+func (r *Relay) Submit(provider *ethclient.Client, tx *types.Transaction) error {
+	ctx := context.Background()
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode transaction: %v", err)
+	}
+
+	start, err := provider.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get block number: %v", err)
+	}
+	last := start + r.fallbackBlocks
+
+	if err := r.send(raw, start+1, last); err != nil {
+		warningText.Printf("Relay rejected %s, sending it to the public mempool: %v\n", tx.Hash().Hex(), err)
+		return provider.SendTransaction(ctx, tx)
+	}
+	infoText.Printf("Transaction %s sent to relay %s, waiting up to %d blocks\n", tx.Hash().Hex(), rpcroute.Redact(r.url), r.fallbackBlocks)
+
+	//! Inclusion tracking
+	block := start
+	for block < last {
+		time.Sleep(PollInterval)
+		current, err := provider.BlockNumber(ctx)
+		if err != nil {
+			log.Printf("Failed to get block number: %v", err)
+			continue
+		}
+		if current == block {
+			continue
+		}
+		block = current
+		if included(provider, tx) {
+			return nil
+		}
+		if r.method == MethodBundle && block < last {
+			if err := r.send(raw, block+1, last); err != nil {
+				log.Printf("Failed to resend bundle for block %d: %v", block+1, err)
+			}
+		}
+	}
+
+	//! Public fallback
+	warningText.Printf("Transaction %s not included after %d blocks, sending it to the public mempool\n", tx.Hash().Hex(), r.fallbackBlocks)
+	if err := provider.SendTransaction(ctx, tx); err != nil {
+		// the relay may have landed it while we were waiting for the next block
+		if included(provider, tx) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func included(provider *ethclient.Client, tx *types.Transaction) bool {
+	_, err := provider.TransactionReceipt(context.Background(), tx.Hash())
+	return err == nil
+}
+
+type request struct {
+	JsonRpc string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type response struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// send posts the transaction with the relay method, a bundle targets block, a private
+// transaction is valid up to maxBlock
+func (r *Relay) send(raw []byte, block uint64, maxBlock uint64) error {
+	var params interface{}
+	switch r.method {
+	case MethodBundle:
+		params = map[string]interface{}{
+			"txs":         []string{hexutil.Encode(raw)},
+			"blockNumber": hexutil.Uint64(block),
+		}
+	default:
+		params = map[string]interface{}{
+			"tx":             hexutil.Encode(raw),
+			"maxBlockNumber": hexutil.Uint64(maxBlock),
+		}
+	}
+
+	body, err := json.Marshal(request{JsonRpc: "2.0", ID: r.id.Add(1), Method: r.method, Params: []interface{}{params}})
+	if err != nil {
+		return err
+	}
+	signature, err := r.sign(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid relay url %s", rpcroute.Redact(r.url))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Flashbots-Signature", signature)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach relay %s", rpcroute.Redact(r.url))
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("failed to read relay response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("relay returned %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	var result response
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("failed to decode relay response: %v", err)
+	}
+	if result.Error != nil {
+		return fmt.Errorf("relay error %d: %s", result.Error.Code, result.Error.Message)
+	}
+	return nil
+}
+
+// sign returns the X-Flashbots-Signature header: the auth address and its EIP-191 signature
+// of the hex encoded keccak256 of the body
+func (r *Relay) sign(body []byte) (string, error) {
+	digest := hexutil.Encode(crypto.Keccak256(body))
+	signature, err := crypto.Sign(accounts.TextHash([]byte(digest)), r.authKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign relay request: %v", err)
+	}
+	return crypto.PubkeyToAddress(r.authKey.PublicKey).Hex() + ":" + hexutil.Encode(signature), nil
+}
@@ -0,0 +1,335 @@
+package relay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"puffDep/config"
+)
+
+const authKey = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+
+type rpcRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func writeResult(w http.ResponseWriter, id json.RawMessage, result interface{}) {
+	json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": id, "result": result})
+}
+
+// node is a stub execution client: the block number advances on every eth_blockNumber call after
+// the first, and the transaction gets a receipt from includeAt on
+type node struct {
+	t         *testing.T
+	mu        sync.Mutex
+	block     uint64
+	polled    bool
+	includeAt uint64
+	tx        common.Hash
+	sentRaw   []string
+}
+
+func (n *node) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req rpcRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		n.t.Errorf("node got an invalid request: %v", err)
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	switch req.Method {
+	case "eth_blockNumber":
+		if n.polled {
+			n.block++
+		}
+		n.polled = true
+		writeResult(w, req.ID, hexutil.Uint64(n.block))
+	case "eth_getTransactionReceipt":
+		if n.includeAt == 0 || n.block < n.includeAt {
+			writeResult(w, req.ID, nil)
+			return
+		}
+		writeResult(w, req.ID, &types.Receipt{
+			Status:      types.ReceiptStatusSuccessful,
+			TxHash:      n.tx,
+			BlockNumber: new(big.Int).SetUint64(n.includeAt),
+			Logs:        []*types.Log{},
+		})
+	case "eth_sendRawTransaction":
+		var raw string
+		json.Unmarshal(req.Params[0], &raw)
+		n.sentRaw = append(n.sentRaw, raw)
+		writeResult(w, req.ID, n.tx)
+	default:
+		n.t.Errorf("node got unexpected method %s", req.Method)
+	}
+}
+
+func (n *node) sent() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.sentRaw...)
+}
+
+// relayCall is one request the stub relay received
+type relayCall struct {
+	body      []byte
+	signature string
+	method    string
+	params    map[string]interface{}
+}
+
+// stubRelay records every request and answers with reject as the JSON-RPC error when it is set
+type stubRelay struct {
+	mu     sync.Mutex
+	calls  []relayCall
+	reject string
+}
+
+func (s *stubRelay) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	var req struct {
+		ID     json.RawMessage          `json:"id"`
+		Method string                   `json:"method"`
+		Params []map[string]interface{} `json:"params"`
+	}
+	json.Unmarshal(body, &req)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	call := relayCall{body: body, signature: r.Header.Get("X-Flashbots-Signature"), method: req.Method}
+	if len(req.Params) == 1 {
+		call.params = req.Params[0]
+	}
+	s.calls = append(s.calls, call)
+
+	if s.reject != "" {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0", "id": req.ID, "error": map[string]interface{}{"code": -32000, "message": s.reject},
+		})
+		return
+	}
+	writeResult(w, req.ID, map[string]string{"bundleHash": "0x01"})
+}
+
+func (s *stubRelay) received() []relayCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]relayCall(nil), s.calls...)
+}
+
+type fixture struct {
+	relay    *Relay
+	stub     *stubRelay
+	node     *node
+	provider *ethclient.Client
+	tx       *types.Transaction
+	raw      string
+}
+
+func newFixture(t *testing.T, method string, fallbackBlocks uint64, startBlock uint64) *fixture {
+	t.Helper()
+	interval := PollInterval
+	PollInterval = time.Millisecond
+	t.Cleanup(func() { PollInterval = interval })
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     7,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(30e9),
+		Gas:       21000,
+		To:        &common.Address{1},
+		Value:     big.NewInt(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &fixture{
+		stub: &stubRelay{},
+		node: &node{t: t, block: startBlock, tx: tx.Hash()},
+		tx:   tx,
+		raw:  hexutil.Encode(raw),
+	}
+	relayServer := httptest.NewServer(f.stub)
+	t.Cleanup(relayServer.Close)
+	nodeServer := httptest.NewServer(f.node)
+	t.Cleanup(nodeServer.Close)
+
+	f.provider, err = ethclient.Dial(nodeServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(f.provider.Close)
+
+	var cfg config.Config
+	cfg.Relay.Url = relayServer.URL
+	cfg.Relay.Method = method
+	cfg.Relay.AuthKey = "0x" + authKey
+	cfg.Relay.FallbackBlocks = fallbackBlocks
+	f.relay, err = New(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestNewWithoutUrl(t *testing.T) {
+	r, err := New(&config.Config{})
+	if r != nil || err != nil {
+		t.Errorf("New without relay.url = %v, %v, want nil, nil", r, err)
+	}
+}
+
+func TestSignatureHeader(t *testing.T) {
+	f := newFixture(t, MethodPrivateTransaction, 5, 100)
+	f.node.includeAt = 101
+	if err := f.relay.Submit(f.provider, f.tx); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := f.stub.received()
+	if len(calls) == 0 {
+		t.Fatal("relay got no request")
+	}
+	address, signature, ok := strings.Cut(calls[0].signature, ":")
+	if !ok {
+		t.Fatalf("signature header %q is not address:signature", calls[0].signature)
+	}
+	key, _ := crypto.HexToECDSA(authKey)
+	if want := crypto.PubkeyToAddress(key.PublicKey); address != want.Hex() {
+		t.Errorf("header address = %s, want %s", address, want.Hex())
+	}
+
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := hexutil.Encode(crypto.Keccak256(calls[0].body))
+	pub, err := crypto.SigToPub(accounts.TextHash([]byte(digest)), sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recovered := crypto.PubkeyToAddress(*pub); recovered.Hex() != address {
+		t.Errorf("signature recovers %s, want %s", recovered.Hex(), address)
+	}
+}
+
+func TestPrivateTransactionParams(t *testing.T) {
+	f := newFixture(t, MethodPrivateTransaction, 5, 100)
+	f.node.includeAt = 102
+	if err := f.relay.Submit(f.provider, f.tx); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := f.stub.received()
+	if len(calls) != 1 {
+		t.Fatalf("relay got %d requests, want the private transaction once", len(calls))
+	}
+	if calls[0].method != MethodPrivateTransaction {
+		t.Errorf("method = %s", calls[0].method)
+	}
+	if calls[0].params["tx"] != f.raw {
+		t.Errorf("tx = %v, want %s", calls[0].params["tx"], f.raw)
+	}
+	if want := hexutil.Uint64(105).String(); calls[0].params["maxBlockNumber"] != want {
+		t.Errorf("maxBlockNumber = %v, want %s", calls[0].params["maxBlockNumber"], want)
+	}
+	if sent := f.node.sent(); len(sent) != 0 {
+		t.Errorf("included transaction was also sent to the public mempool")
+	}
+}
+
+func TestBundleResentEveryBlock(t *testing.T) {
+	f := newFixture(t, MethodBundle, 10, 100)
+	f.node.includeAt = 104
+	if err := f.relay.Submit(f.provider, f.tx); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := f.stub.received()
+	var blocks []string
+	for _, c := range calls {
+		if c.method != MethodBundle {
+			t.Errorf("method = %s", c.method)
+		}
+		txs, _ := c.params["txs"].([]interface{})
+		if len(txs) != 1 || txs[0] != f.raw {
+			t.Errorf("txs = %v, want [%s]", c.params["txs"], f.raw)
+		}
+		blocks = append(blocks, fmt.Sprint(c.params["blockNumber"]))
+	}
+	// targets the next block, then every new block until the receipt shows up in 104
+	want := []string{"0x65", "0x66", "0x67", "0x68"}
+	if strings.Join(blocks, ",") != strings.Join(want, ",") {
+		t.Errorf("bundle targeted blocks %v, want %v", blocks, want)
+	}
+	if sent := f.node.sent(); len(sent) != 0 {
+		t.Errorf("included bundle was also sent to the public mempool")
+	}
+}
+
+func TestFallbackAfterBlocks(t *testing.T) {
+	f := newFixture(t, MethodBundle, 3, 100)
+	if err := f.relay.Submit(f.provider, f.tx); err != nil {
+		t.Fatal(err)
+	}
+
+	// sent for 101, resent for 102 and 103, then the wait ends at block 103
+	if calls := f.stub.received(); len(calls) != 3 {
+		t.Errorf("relay got %d requests, want 3", len(calls))
+	}
+	sent := f.node.sent()
+	if len(sent) != 1 || sent[0] != f.raw {
+		t.Errorf("public mempool got %v, want the transaction once", sent)
+	}
+	if f.node.block != 103 {
+		t.Errorf("fell back at block %d, want 103", f.node.block)
+	}
+}
+
+func TestFallbackWhenRejected(t *testing.T) {
+	f := newFixture(t, MethodPrivateTransaction, 25, 100)
+	f.stub.reject = "insufficient fee"
+	if err := f.relay.Submit(f.provider, f.tx); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls := f.stub.received(); len(calls) != 1 {
+		t.Errorf("relay got %d requests, want 1", len(calls))
+	}
+	sent := f.node.sent()
+	if len(sent) != 1 || sent[0] != f.raw {
+		t.Errorf("public mempool got %v, want the transaction once", sent)
+	}
+	// no waiting for blocks after a rejection
+	if f.node.block != 100 {
+		t.Errorf("waited until block %d before falling back", f.node.block)
+	}
+}
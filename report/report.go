@@ -0,0 +1,190 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"puffDep/karak"
+	"puffDep/multicall"
+	"puffDep/puff"
+	"puffDep/rpcroute"
+	"puffDep/wallet"
+)
+
+// VaultPosition is a wallet's stake in one Karak vault. Assets are in units of the vault's own asset,
+// so positions of different vaults are never added up.
+type VaultPosition struct {
+	Vault  string         `json:"vault"`
+	Asset  common.Address `json:"asset"`
+	Shares *big.Int       `json:"shares"`
+	Assets *big.Int       `json:"assets"`
+}
+
+// WalletPosition is everything we hold in a single wallet, amounts are in wei. Vaults are in the
+// order of the configured Karak vaults.
+type WalletPosition struct {
+	Index          int             `json:"index"`
+	Address        common.Address  `json:"address"`
+	EthBalance     *big.Int        `json:"ethBalance"`
+	PuffEthBalance *big.Int        `json:"puffEthBalance"`
+	Vaults         []VaultPosition `json:"vaults"`
+	PendingNonce   uint64          `json:"pendingNonce"`
+	Error          string          `json:"error,omitempty"`
+}
+
+// Totals sums the positions of all wallets that were read successfully, per vault
+type Totals struct {
+	Wallets        int             `json:"wallets"`
+	Failed         int             `json:"failed"`
+	EthBalance     *big.Int        `json:"ethBalance"`
+	PuffEthBalance *big.Int        `json:"puffEthBalance"`
+	Vaults         []VaultPosition `json:"vaults"`
+}
+
+type Report struct {
+	Wallets []WalletPosition `json:"wallets"`
+	Totals  Totals           `json:"totals"`
+}
+
+// newVaultPositions returns an empty position in every vault
+func newVaultPositions(vaults []karak.Vault) []VaultPosition {
+	positions := make([]VaultPosition, len(vaults))
+	for i, v := range vaults {
+		positions[i] = VaultPosition{Vault: v.Name, Asset: v.Asset, Shares: new(big.Int), Assets: new(big.Int)}
+	}
+	return positions
+}
+
+// Collect reads the position of every wallet over its own route. Balances, shares and their value
+// are read in batched multicalls per route, Karak shares and their value are kept per vault. A
+// wallet that can't be read is kept in the report with its error set and left out of the totals.
+func Collect(pool *rpcroute.Pool, vaults []karak.Vault, wallets []wallet.Wallet) *Report {
+	r := &Report{
+		Totals: Totals{
+			EthBalance:     new(big.Int),
+			PuffEthBalance: new(big.Int),
+			Vaults:         newVaultPositions(vaults),
+		},
+	}
+
+	for _, position := range routedPositions(pool, vaults, wallets) {
+		if position.Error != "" {
+			r.Wallets = append(r.Wallets, WalletPosition{Index: position.Index, Address: position.Address, Error: position.Error})
+			r.Totals.Failed++
+			continue
+		}
+		r.Wallets = append(r.Wallets, *position)
+		r.Totals.Wallets++
+		r.Totals.EthBalance.Add(r.Totals.EthBalance, position.EthBalance)
+		r.Totals.PuffEthBalance.Add(r.Totals.PuffEthBalance, position.PuffEthBalance)
+		for i, v := range position.Vaults {
+			r.Totals.Vaults[i].Shares.Add(r.Totals.Vaults[i].Shares, v.Shares)
+			r.Totals.Vaults[i].Assets.Add(r.Totals.Vaults[i].Assets, v.Assets)
+		}
+	}
+	return r
+}
+
+// routedPositions reads the wallets of every route in one batch, the wallets of a route that
+// can't be dialed or read get its error
+func routedPositions(pool *rpcroute.Pool, vaults []karak.Vault, wallets []wallet.Wallet) []*WalletPosition {
+	routes := make([]rpcroute.Route, len(wallets))
+	for i, w := range wallets {
+		routes[i] = w.Route
+	}
+
+	positions := make([]*WalletPosition, len(wallets))
+	for _, g := range rpcroute.Groups(routes) {
+		group := make([]wallet.Wallet, len(g.Indexes))
+		for i, index := range g.Indexes {
+			group[i] = wallets[index]
+		}
+
+		client, err := pool.Client(g.Route)
+		var read []*WalletPosition
+		if err == nil {
+			read, err = readPositions(client, vaults, group)
+		}
+		for i, index := range g.Indexes {
+			if err != nil {
+				positions[index] = &WalletPosition{Index: group[i].Index, Address: group[i].Address, Error: err.Error()}
+				continue
+			}
+			positions[index] = read[i]
+		}
+	}
+	return positions
+}
+
+func readPositions(client *ethclient.Client, vaults []karak.Vault, wallets []wallet.Wallet) ([]*WalletPosition, error) {
+	ctx := context.Background()
+
+	//! Balances and vault shares of all wallets
+	owners := make([]common.Address, len(wallets))
+	for i, w := range wallets {
+		owners[i] = w.Address
+	}
+	puffEth := common.HexToAddress(puff.EthPuffTokenContractAddress)
+	tokens := []common.Address{puffEth}
+	for _, v := range vaults {
+		tokens = append(tokens, v.Address)
+	}
+	balances, err := multicall.ReadBalances(client, owners, multicall.Query{Eth: true, Tokens: tokens})
+	if err != nil {
+		return nil, err
+	}
+
+	//! Value of the vault shares
+	positions := make([]*WalletPosition, len(wallets))
+	var calls []*multicall.Call
+	// callWallet is the wallet index of every call, a failed conversion only marks its own wallet
+	var callWallet []int
+	for i, w := range wallets {
+		b := balances[i]
+		positions[i] = &WalletPosition{Index: w.Index, Address: w.Address, Vaults: newVaultPositions(vaults)}
+		if b.Err != nil {
+			positions[i].Error = fmt.Sprintf("failed to read balances: %v", b.Err)
+			continue
+		}
+		positions[i].EthBalance = b.Eth
+		positions[i].PuffEthBalance = b.Token(puffEth)
+
+		for j, v := range vaults {
+			shares := b.Token(v.Address)
+			if shares.Sign() == 0 {
+				continue
+			}
+			positions[i].Vaults[j].Shares = shares
+			c, err := karak.ConvertToAssetsCall(v, shares, &positions[i].Vaults[j].Assets)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, c)
+			callWallet = append(callWallet, i)
+		}
+	}
+	if err := multicall.Do(client, calls); err != nil {
+		return nil, err
+	}
+	for k, c := range calls {
+		if p := positions[callWallet[k]]; c.Err != nil && p.Error == "" {
+			p.Error = fmt.Sprintf("failed to convert vault shares: %v", c.Err)
+		}
+	}
+
+	for _, p := range positions {
+		if p.Error != "" {
+			continue
+		}
+		nonce, err := client.PendingNonceAt(ctx, p.Address)
+		if err != nil {
+			p.Error = fmt.Sprintf("failed to get pending nonce: %v", err)
+			continue
+		}
+		p.PendingNonce = nonce
+	}
+	return positions, nil
+}
@@ -0,0 +1,87 @@
+package rpcroute
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Pool hands out the client of a route: the shared client for the default route, and a client
+// dialed on first use for every other route. Wallets on the same route share its client.
+type Pool struct {
+	shared     *ethclient.Client
+	defaultRpc string
+
+	mu      sync.Mutex
+	chainID *big.Int
+	clients map[Route]*ethclient.Client
+}
+
+// NewPool builds a pool around the shared client, routes without an rpc of their own dial defaultRpc
+func NewPool(shared *ethclient.Client, defaultRpc string) *Pool {
+	return &Pool{shared: shared, defaultRpc: defaultRpc, clients: make(map[Route]*ethclient.Client)}
+}
+
+// Client returns the client of route, dialing it when it is used for the first time. Routed
+// clients are checked against the chain ID of the shared client.
+func (p *Pool) Client(route Route) (*ethclient.Client, error) {
+	if route.IsDefault() {
+		return p.shared, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if client, ok := p.clients[route]; ok {
+		return client, nil
+	}
+	if p.chainID == nil {
+		chainID, err := p.shared.ChainID(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get chain ID: %v", err)
+		}
+		p.chainID = chainID
+	}
+
+	client, err := Dial(route, p.defaultRpc, p.chainID)
+	if err != nil {
+		return nil, err
+	}
+	p.clients[route] = client
+	return client, nil
+}
+
+// Close closes the dialed clients, the shared one is left to its owner
+func (p *Pool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for route, client := range p.clients {
+		client.Close()
+		delete(p.clients, route)
+	}
+}
+
+// Group is the positions, in a list of routes, of the ones that are the same route
+type Group struct {
+	Route   Route
+	Indexes []int
+}
+
+// Groups splits routes into groups of the same route, in the order they first show up, so
+// batched reads can go over each route once
+func Groups(routes []Route) []Group {
+	var groups []Group
+	at := make(map[Route]int)
+	for i, route := range routes {
+		g, ok := at[route]
+		if !ok {
+			g = len(groups)
+			at[route] = g
+			groups = append(groups, Group{Route: route})
+		}
+		groups[g].Indexes = append(groups[g].Indexes, i)
+	}
+	return groups
+}
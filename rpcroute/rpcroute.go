@@ -0,0 +1,113 @@
+package rpcroute
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+)
+
+// RpcSchemes are the rpc url schemes a client can dial
+var RpcSchemes = []string{"http", "https", "ws", "wss"}
+
+// ProxySchemes are the proxy url schemes net/http can dial through
+var ProxySchemes = []string{"http", "https", "socks5"}
+
+// Route is how a wallet reaches the node: its own rpc endpoint, a proxy, or both.
+// The zero Route uses the shared client.
+type Route struct {
+	Rpc   string
+	Proxy string
+}
+
+func (r Route) IsDefault() bool {
+	return r.Rpc == "" && r.Proxy == ""
+}
+
+// String describes the route with credentials redacted
+func (r Route) String() string {
+	var parts []string
+	if r.Rpc != "" {
+		parts = append(parts, "rpc "+Redact(r.Rpc))
+	}
+	if r.Proxy != "" {
+		parts = append(parts, "proxy "+Redact(r.Proxy))
+	}
+	if len(parts) == 0 {
+		return "default"
+	}
+	return strings.Join(parts, " via ")
+}
+
+// Dial connects to the route's rpc, or defaultRpc when it has none, through its proxy and checks
+// that the node is on chainID. Dialing is checked with a chain ID request, so a dead proxy fails here.
+func Dial(route Route, defaultRpc string, chainID *big.Int) (*ethclient.Client, error) {
+	endpoint := route.Rpc
+	if endpoint == "" {
+		endpoint = defaultRpc
+	}
+
+	var options []rpc.ClientOption
+	if route.Proxy != "" {
+		proxyUrl, err := url.Parse(route.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy %s: %v", Redact(route.Proxy), err)
+		}
+		options = append(options,
+			rpc.WithHTTPClient(&http.Client{Transport: &http.Transport{
+				Proxy:               http.ProxyURL(proxyUrl),
+				TLSHandshakeTimeout: 15 * time.Second,
+				IdleConnTimeout:     90 * time.Second,
+			}}),
+			rpc.WithWebsocketDialer(websocket.Dialer{
+				Proxy:            http.ProxyURL(proxyUrl),
+				HandshakeTimeout: 15 * time.Second,
+			}),
+		)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := rpc.DialOptions(ctx, endpoint, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect over %s: %s", route, scrub(err, endpoint))
+	}
+	ethClient := ethclient.NewClient(client)
+
+	remoteChainID, err := ethClient.ChainID(ctx)
+	if err != nil {
+		ethClient.Close()
+		return nil, fmt.Errorf("failed to reach the node over %s: %s", route, scrub(err, endpoint))
+	}
+	if remoteChainID.Cmp(chainID) != 0 {
+		ethClient.Close()
+		return nil, fmt.Errorf("node over %s is on chain %s, expected %s", route, remoteChainID, chainID)
+	}
+	return ethClient, nil
+}
+
+// scrub redacts the endpoint in err, net/http errors quote the full request url
+func scrub(err error, endpoint string) string {
+	return strings.ReplaceAll(err.Error(), strings.TrimSuffix(endpoint, "/"), Redact(endpoint))
+}
+
+// Redact keeps only the scheme and host of a url, rpc providers put api keys in the path or
+// query and proxies carry credentials
+func Redact(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "<redacted>"
+	}
+	if u.Path == "" && u.RawQuery == "" && u.User == nil {
+		return raw
+	}
+	return u.Scheme + "://" + u.Host + "/<redacted>"
+}
@@ -0,0 +1,144 @@
+package runner
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"puffDep/formatter"
+	"puffDep/multicall"
+	"puffDep/puff"
+	"puffDep/wallet"
+)
+
+// assetBalances reads the wallet's balance of every asset the Puffer vault accepts in one multicall
+func (r *Runner) assetBalances(w wallet.Wallet) (map[string]*big.Int, error) {
+	client, err := r.client(w)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := []string{puff.AssetWeth, puff.AssetStEth}
+	var query multicall.Query
+	query.Eth = true
+	for _, asset := range tokens {
+		token, _ := puff.TokenAddress(asset)
+		query.Tokens = append(query.Tokens, common.HexToAddress(token))
+	}
+	read, err := multicall.ReadBalances(client, []common.Address{w.Address}, query)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get balances: %v", err)
+	}
+	if read[0].Err != nil {
+		return nil, fmt.Errorf("Failed to get balances: %v", read[0].Err)
+	}
+
+	balances := map[string]*big.Int{puff.AssetEth: read[0].Eth}
+	for i, asset := range tokens {
+		balances[asset] = read[0].Token(query.Tokens[i])
+	}
+	return balances, nil
+}
+
+// pickAsset returns the configured deposit asset, or with "auto" the one the wallet holds most of.
+// All three are worth about one ETH, so their balances are compared directly.
+func pickAsset(configured string, balances map[string]*big.Int) string {
+	if configured != "auto" && configured != "" {
+		return configured
+	}
+	picked := puff.AssetEth
+	for _, asset := range []string{puff.AssetWeth, puff.AssetStEth} {
+		if balances[asset].Cmp(balances[picked]) > 0 {
+			picked = asset
+		}
+	}
+	return picked
+}
+
+// DepositPuffer deposits a random share of the wallet's ETH, WETH or stETH into puffETH
+func (r *Runner) DepositPuffer(w wallet.Wallet) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.record(w, StepDepositPuffer, nil, nil, err)
+	}
+
+	//! Asset Balances
+	balances, err := r.assetBalances(w)
+	if err != nil {
+		return r.record(w, StepDepositPuffer, nil, nil, err)
+	}
+	cfg := r.walletConfig(w)
+	workflow := cfg.Get().Ethereum.Workflow
+	asset := pickAsset(workflow.DepositAsset, balances)
+	balance := balances[asset]
+
+	//! Generating random amount of the asset for deposit to puffEth
+	amount := getRandomAmount(balance, workflow.WorkAmountRangePercent.Min, workflow.WorkAmountRangePercent.Max)
+	if amount.Sign() == 0 {
+		return r.recordOn(asset, w, StepDepositPuffer, amount, nil, fmt.Errorf("%w: %s balance is zero", ErrNothingToDo, asset))
+	}
+
+	warningText.Printf("Randomed value to Deposit:%f / %s Balance: %f\n", formatter.ConvertWeiToEther(amount), asset, formatter.ConvertWeiToEther(balance))
+
+	//! Tokens need an allowance for the vault first
+	if asset != puff.AssetEth {
+		if err := r.approveAsset(w, asset, amount); err != nil {
+			return err
+		}
+	}
+
+	receiver := w.Address
+	if workflow.Receiver != "" {
+		receiver = common.HexToAddress(workflow.Receiver)
+	}
+
+	//! Main Dep function
+	infoText.Printf("Depositing %f %s to PuffEth\n", formatter.ConvertWeiToEther(amount), asset)
+	if receiver != w.Address {
+		infoText.Printf("puffETH goes to %s\n", receiver.Hex())
+	}
+	var tx *formatter.TxResult
+	switch asset {
+	case puff.AssetEth:
+		tx, err = puff.DepositEth(client, w.Signer, amount, receiver, cfg)
+	case puff.AssetWeth:
+		tx, err = puff.DepositWeth(client, w.Signer, amount, receiver, cfg)
+	case puff.AssetStEth:
+		tx, err = puff.DepositStEth(client, w.Signer, amount, receiver, cfg)
+	default:
+		err = fmt.Errorf("unknown deposit asset %q", asset)
+	}
+	if err != nil {
+		return r.recordOn(asset, w, StepDepositPuffer, amount, tx, fmt.Errorf("Failed to deposit to PuffEth: %v", err))
+	}
+	greenText.Printf("Successful deposit: %s\n", tx.Url())
+	return r.recordOn(asset, w, StepDepositPuffer, amount, tx, nil)
+}
+
+// approveAsset makes sure the Puffer vault may pull amount of the asset token from the wallet
+func (r *Runner) approveAsset(w wallet.Wallet, asset string, amount *big.Int) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.recordOn(asset, w, StepApproveAsset, amount, nil, err)
+	}
+
+	token, err := puff.TokenAddress(asset)
+	if err != nil {
+		return r.recordOn(asset, w, StepApproveAsset, amount, nil, err)
+	}
+	allowance, err := puff.GetTokenAllowance(client, token, w.Address, puff.EthPuffTokenContractAddress)
+	if err != nil {
+		return r.recordOn(asset, w, StepApproveAsset, amount, nil, fmt.Errorf("Failed to get %s allowance: %v", asset, err))
+	}
+	if allowance.Cmp(amount) >= 0 {
+		return nil
+	}
+
+	infoText.Printf("Approving %f %s for the Puffer vault\n", formatter.ConvertWeiToEther(amount), asset)
+	tx, err := puff.ApproveToken(client, w.Signer, token, puff.EthPuffTokenContractAddress, amount, r.walletConfig(w))
+	if err != nil {
+		return r.recordOn(asset, w, StepApproveAsset, amount, tx, fmt.Errorf("Failed to approve %s: %v", asset, err))
+	}
+	greenText.Printf("Successful approve: %s\n", tx.Url())
+	return r.recordOn(asset, w, StepApproveAsset, amount, tx, nil)
+}
@@ -0,0 +1,142 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"math/rand"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"puffDep/config"
+	"puffDep/delayer"
+	"puffDep/formatter"
+	"puffDep/journal"
+	"puffDep/metrics"
+	"puffDep/multicall"
+	"puffDep/notify"
+	"puffDep/signer"
+	"puffDep/wallet"
+)
+
+// FundedWallets returns the wallets that were funded successfully in the run
+func FundedWallets(records []journal.Record, runID string) map[common.Address]bool {
+	funded := make(map[common.Address]bool)
+	for _, rec := range records {
+		if rec.RunID == runID && rec.Step == StepFund && rec.Status == journal.StatusSuccess {
+			funded[common.HexToAddress(rec.Wallet)] = true
+		}
+	}
+	return funded
+}
+
+// fundAmount is what to send to a wallet: the shortfall to its target balance when a target is
+// configured, otherwise a random amount of the range
+func fundAmount(cfg *config.Config, address common.Address, balance *big.Int) *big.Int {
+	target := cfg.Fund.TargetEth
+	for a, t := range cfg.Fund.Targets {
+		if strings.EqualFold(a, address.Hex()) {
+			target = t
+		}
+	}
+	if target > 0 {
+		shortfall := new(big.Int).Sub(formatter.ConvertEtherToWei(target), balance)
+		if shortfall.Sign() < 0 {
+			return new(big.Int)
+		}
+		return shortfall
+	}
+
+	amountRange := cfg.Fund.AmountRangeEth
+	return formatter.ConvertEtherToWei(amountRange.Min + rand.Float64()*(amountRange.Max-amountRange.Min))
+}
+
+// Fund sends ETH from the master key to every wallet, skipping the ones in funded. The master's
+// nonces are tracked locally across the sends and every transfer is journaled under the wallet.
+func (r *Runner) Fund(master signer.Signer, wallets []wallet.Wallet, funded map[common.Address]bool) error {
+	masterAddress := master.Address()
+	cfg := r.Config.Get()
+
+	//! Plan the transfers from the current balances
+	owners := []common.Address{masterAddress}
+	for _, w := range wallets {
+		owners = append(owners, w.Address)
+	}
+	balances, err := multicall.ReadBalances(r.Client, owners, multicall.Query{Eth: true})
+	if err != nil {
+		return err
+	}
+	if balances[0].Err != nil {
+		return fmt.Errorf("Failed to get master balance: %v", balances[0].Err)
+	}
+	amounts := make([]*big.Int, len(wallets))
+	total := new(big.Int)
+	for i, w := range wallets {
+		if funded[w.Address] || balances[i+1].Err != nil {
+			continue
+		}
+		amounts[i] = fundAmount(cfg, w.Address, balances[i+1].Eth)
+		total.Add(total, amounts[i])
+	}
+	infoText.Printf("Funding from %s, balance %f, planned %f\n", masterAddress.Hex(), formatter.ConvertWeiToEther(balances[0].Eth), formatter.ConvertWeiToEther(total))
+	if total.Cmp(balances[0].Eth) > 0 {
+		warningText.Printf("Master balance does not cover the planned transfers and their gas\n")
+	}
+
+	nonces := formatter.NewNonces(masterAddress)
+	summary := notify.Event{Type: notify.EventRunDone, RunID: r.RunID}
+	for i, w := range wallets {
+		if funded[w.Address] {
+			warningText.Printf("Skipping %s: funded in run %s already\n", w.Address.Hex(), r.RunID)
+			continue
+		}
+		delayer.WaitWhilePaused(r.Config)
+		warningText.Printf("Funding address: %s\n", w.Address.Hex())
+
+		err := r.fundWallet(master, nonces, w, balances[i+1], amounts[i])
+		switch {
+		case errors.Is(err, ErrNothingToDo):
+			warningText.Printf("Skipping %s: %v\n", w.Address.Hex(), err)
+			metrics.WalletsTotal.WithLabelValues(metrics.WalletSkipped).Inc()
+			summary.Skipped++
+			continue
+		case err != nil:
+			log.Printf("%v", err)
+			metrics.WalletsTotal.WithLabelValues(metrics.WalletFailed).Inc()
+			summary.Failed++
+		default:
+			metrics.WalletsTotal.WithLabelValues(metrics.WalletProcessed).Inc()
+			summary.Processed++
+		}
+
+		//! Delay Wallets
+		if i < len(wallets)-1 {
+			delayer.DelayWallet(r.Config)
+		}
+	}
+	r.Notifier.Notify(summary)
+	return nil
+}
+
+func (r *Runner) fundWallet(master signer.Signer, nonces *formatter.Nonces, w wallet.Wallet, balance *multicall.Balances, amount *big.Int) error {
+	masterAddress := master.Address()
+	if balance.Err != nil {
+		return r.recordFrom(masterAddress, w, StepFund, nil, nil, fmt.Errorf("Failed to get balance: %v", balance.Err))
+	}
+	if amount.Sign() == 0 {
+		return r.recordFrom(masterAddress, w, StepFund, amount, nil, fmt.Errorf("%w: balance %f is at its target", ErrNothingToDo, formatter.ConvertWeiToEther(balance.Eth)))
+	}
+
+	if err := formatter.CheckGasPrice(r.Client, r.Config); err != nil {
+		return r.recordFrom(masterAddress, w, StepFund, amount, nil, err)
+	}
+
+	infoText.Printf("Sending %f ETH to %s\n", formatter.ConvertWeiToEther(amount), w.Address.Hex())
+	tx, err := formatter.Transfer(r.Client, master, w.Address, amount, nonces)
+	if err != nil {
+		return r.recordFrom(masterAddress, w, StepFund, amount, tx, fmt.Errorf("Failed to fund %s: %v", w.Address.Hex(), err))
+	}
+	greenText.Printf("Successful transfer: %s\n", tx.Url())
+	return r.recordFrom(masterAddress, w, StepFund, amount, tx, nil)
+}
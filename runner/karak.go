@@ -0,0 +1,243 @@
+package runner
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"puffDep/formatter"
+	"puffDep/karak"
+	"puffDep/multicall"
+	"puffDep/puff"
+	"puffDep/wallet"
+)
+
+// allocation is the part of a wallet's balance that goes into one vault
+type allocation struct {
+	Vault     karak.Vault
+	Amount    *big.Int
+	Allowance *big.Int
+}
+
+// karakVaults returns the configured vaults, checking each vault's asset() on first use
+func (r *Runner) karakVaults() ([]karak.Vault, error) {
+	r.vaultsMu.Lock()
+	defer r.vaultsMu.Unlock()
+	if r.vaults != nil {
+		return r.vaults, nil
+	}
+
+	vaults, err := karak.Vaults(r.Config.Get())
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vaults {
+		if err := karak.ValidateAsset(r.Client, v); err != nil {
+			return nil, err
+		}
+	}
+	r.vaults = vaults
+	return vaults, nil
+}
+
+// walletVaults returns the vaults the wallet deposits into: all of them, or the one named by its
+// karakVault override
+func (r *Runner) walletVaults(w wallet.Wallet) ([]karak.Vault, error) {
+	vaults, err := r.karakVaults()
+	if err != nil {
+		return nil, err
+	}
+	if w.Overrides.KarakVault == "" {
+		return vaults, nil
+	}
+
+	var selected []karak.Vault
+	for _, kv := range r.walletConfig(w).Get().Karak.Vaults {
+		for _, v := range vaults {
+			if v.Address == common.HexToAddress(kv.Vault) {
+				selected = append(selected, v)
+			}
+		}
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("karak vault %q of the wallet overrides is not configured", w.Overrides.KarakVault)
+	}
+	return selected, nil
+}
+
+// readVaultPositions reads the wallet's balance of every vault asset and its allowance for every vault in one multicall
+func (r *Runner) readVaultPositions(w wallet.Wallet, vaults []karak.Vault) (*multicall.Balances, error) {
+	client, err := r.client(w)
+	if err != nil {
+		return nil, err
+	}
+
+	var query multicall.Query
+	seen := make(map[common.Address]bool)
+	for _, v := range vaults {
+		if !seen[v.Asset] {
+			seen[v.Asset] = true
+			query.Tokens = append(query.Tokens, v.Asset)
+		}
+		query.Allowances = append(query.Allowances, multicall.Allowance{Token: v.Asset, Spender: v.Address})
+	}
+	read, err := multicall.ReadBalances(client, []common.Address{w.Address}, query)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get vault asset balances: %v", err)
+	}
+	if read[0].Err != nil {
+		return nil, fmt.Errorf("Failed to get vault asset balances: %v", read[0].Err)
+	}
+	return read[0], nil
+}
+
+// allocate splits the wallet's balance of every vault asset between the wallet's vaults of that asset by
+// weight. A resumed run splits it between the vaults it didn't deposit into yet, which gives them the
+// share they would have had.
+func (r *Runner) allocate(w wallet.Wallet) ([]allocation, error) {
+	vaults, err := r.walletVaults(w)
+	if err != nil {
+		return nil, err
+	}
+	if deposited := r.resume[w.Address].deposited; len(deposited) > 0 {
+		var left []karak.Vault
+		for _, v := range vaults {
+			if !deposited[v.Name] {
+				left = append(left, v)
+			}
+		}
+		vaults = left
+	}
+	positions, err := r.readVaultPositions(w, vaults)
+	if err != nil {
+		return nil, err
+	}
+
+	var allocations []allocation
+	seen := make(map[common.Address]bool)
+	groups := karak.ByAsset(vaults)
+	// walk in config order so the steps run in a stable order
+	for _, v := range vaults {
+		if seen[v.Asset] {
+			continue
+		}
+		seen[v.Asset] = true
+
+		group := groups[v.Asset]
+		for i, amount := range karak.Allocate(positions.Token(v.Asset), group) {
+			if amount.Sign() > 0 {
+				allocations = append(allocations, allocation{
+					Vault:     group[i],
+					Amount:    amount,
+					Allowance: positions.Allowance(v.Asset, group[i].Address),
+				})
+			}
+		}
+	}
+	return allocations, nil
+}
+
+// Approve approves each Karak vault for its share of the wallet's balance of the vault asset
+func (r *Runner) Approve(w wallet.Wallet) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.record(w, StepApprove, nil, nil, err)
+	}
+
+	allocations, err := r.allocate(w)
+	if err != nil {
+		return r.record(w, StepApprove, nil, nil, err)
+	}
+	if len(allocations) == 0 {
+		return r.record(w, StepApprove, nil, nil, fmt.Errorf("%w: no balance of any vault asset", ErrNothingToDo))
+	}
+
+	for _, a := range allocations {
+		if a.Allowance.Cmp(a.Amount) >= 0 {
+			infoText.Printf("Vault %s is already approved for %f\n", a.Vault.Name, formatter.ConvertWeiToEther(a.Allowance))
+			continue
+		}
+
+		infoText.Printf("Approving %f for Karak vault %s\n", formatter.ConvertWeiToEther(a.Amount), a.Vault.Name)
+		tx, err := puff.ApproveToken(client, w.Signer, a.Vault.Asset.Hex(), a.Vault.Address.Hex(), a.Amount, r.walletConfig(w))
+		if err != nil {
+			return r.recordOn(a.Vault.Name, w, StepApprove, a.Amount, tx, fmt.Errorf("Failed to approve vault %s: %v", a.Vault.Name, err))
+		}
+		greenText.Printf("Successful approve: %s\n", tx.Url())
+		if err := r.recordOn(a.Vault.Name, w, StepApprove, a.Amount, tx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DepositKarak deposits the wallet's balance of every vault asset into the Karak vaults by weight
+func (r *Runner) DepositKarak(w wallet.Wallet) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.record(w, StepDepositKarak, nil, nil, err)
+	}
+
+	allocations, err := r.allocate(w)
+	if err != nil {
+		return r.record(w, StepDepositKarak, nil, nil, err)
+	}
+	if len(allocations) == 0 {
+		return r.record(w, StepDepositKarak, nil, nil, fmt.Errorf("%w: no balance of any vault asset", ErrNothingToDo))
+	}
+
+	for _, a := range allocations {
+		//! Deposit to Karak
+		infoText.Printf("Depositing %f to Karak vault %s\n", formatter.ConvertWeiToEther(a.Amount), a.Vault.Name)
+		tx, err := karak.DepositToKarak(client, w.Signer, a.Vault, a.Amount, r.walletConfig(w))
+		if err != nil {
+			return r.recordOn(a.Vault.Name, w, StepDepositKarak, a.Amount, tx, fmt.Errorf("Failed to deposit to Karak vault %s: %v", a.Vault.Name, err))
+		}
+		greenText.Printf("Successful deposit to Karak: %s\n", tx.Url())
+		if err := r.recordOn(a.Vault.Name, w, StepDepositKarak, a.Amount, tx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Revoke sets the allowance of every Karak vault that still has one back to zero
+func (r *Runner) Revoke(w wallet.Wallet) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.record(w, StepRevoke, nil, nil, err)
+	}
+
+	vaults, err := r.karakVaults()
+	if err != nil {
+		return r.record(w, StepRevoke, nil, nil, err)
+	}
+
+	positions, err := r.readVaultPositions(w, vaults)
+	if err != nil {
+		return r.record(w, StepRevoke, nil, nil, err)
+	}
+
+	revoked := 0
+	for _, v := range vaults {
+		if positions.Allowance(v.Asset, v.Address).Sign() == 0 {
+			continue
+		}
+
+		infoText.Printf("Revoking approval for Karak vault %s\n", v.Name)
+		tx, err := puff.ApproveToken(client, w.Signer, v.Asset.Hex(), v.Address.Hex(), big.NewInt(0), r.walletConfig(w))
+		if err != nil {
+			return r.recordOn(v.Name, w, StepRevoke, big.NewInt(0), tx, fmt.Errorf("Failed to revoke approval of vault %s: %v", v.Name, err))
+		}
+		greenText.Printf("Successful revoke: %s\n", tx.Url())
+		if err := r.recordOn(v.Name, w, StepRevoke, big.NewInt(0), tx, nil); err != nil {
+			return err
+		}
+		revoked++
+	}
+
+	if revoked == 0 {
+		return r.record(w, StepRevoke, nil, nil, fmt.Errorf("%w: no vault approvals", ErrNothingToDo))
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package runner
+
+import (
+	"fmt"
+	"math/big"
+
+	"puffDep/formatter"
+	"puffDep/puff"
+	"puffDep/wallet"
+)
+
+// RedeemShares is how many of the wallet's puffETH shares make up percent of its balance
+func RedeemShares(balance *big.Int, percent int) *big.Int {
+	shares := new(big.Int).Mul(balance, big.NewInt(int64(percent)))
+	return shares.Div(shares, big.NewInt(100))
+}
+
+// Redeem redeems percent of the wallet's puffETH through the Puffer vault and, if unwrap is set,
+// turns the WETH it pays out back into ETH. The amount is capped to what the vault currently
+// allows the wallet to redeem.
+func (r *Runner) Redeem(w wallet.Wallet, percent int, unwrap bool) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.record(w, StepRedeem, nil, nil, err)
+	}
+
+	puffEthBalance, err := puff.GetPuffEthBalance(client, w.Address)
+	if err != nil {
+		return r.record(w, StepRedeem, nil, nil, fmt.Errorf("Failed to get puffEth balance: %v", err))
+	}
+	shares := RedeemShares(puffEthBalance, percent)
+	if shares.Sign() == 0 {
+		return r.record(w, StepRedeem, shares, nil, fmt.Errorf("%w: puffEth balance is zero", ErrNothingToDo))
+	}
+
+	preview, err := puff.PreviewRedeem(client, w.Address, shares)
+	if err != nil {
+		return r.record(w, StepRedeem, shares, nil, fmt.Errorf("Failed to preview redeem: %v", err))
+	}
+	if preview.MaxRedeem.Sign() == 0 {
+		return r.record(w, StepRedeem, shares, nil, fmt.Errorf("%w: vault allows no redemptions right now, daily limit left %f ETH", ErrNothingToDo, formatter.ConvertWeiToEther(preview.DailyLimitLeft)))
+	}
+	if shares.Cmp(preview.MaxRedeem) > 0 {
+		warningText.Printf("Vault limits redemption to %f puffEth\n", formatter.ConvertWeiToEther(preview.MaxRedeem))
+		shares = preview.MaxRedeem
+		if preview, err = puff.PreviewRedeem(client, w.Address, shares); err != nil {
+			return r.record(w, StepRedeem, shares, nil, fmt.Errorf("Failed to preview redeem: %v", err))
+		}
+	}
+
+	wethBefore, err := puff.GetWethBalance(client, w.Address)
+	if err != nil {
+		return r.record(w, StepRedeem, shares, nil, fmt.Errorf("Failed to get WETH balance: %v", err))
+	}
+
+	infoText.Printf("Redeeming %f puffEth for ~%f WETH (exit fee %s bp)\n", formatter.ConvertWeiToEther(shares), formatter.ConvertWeiToEther(preview.AssetsOut), preview.ExitFeeBasisPoints)
+	tx, err := puff.RedeemPuffEth(client, w.Signer, shares, r.walletConfig(w))
+	if err != nil {
+		return r.record(w, StepRedeem, shares, tx, fmt.Errorf("Failed to redeem puffEth: %v", err))
+	}
+	greenText.Printf("Successful redeem: %s\n", tx.Url())
+	if err := r.record(w, StepRedeem, shares, tx, nil); err != nil || !unwrap {
+		return err
+	}
+
+	//! Unwrap exactly what the redemption paid out
+	wethAfter, err := puff.GetWethBalance(client, w.Address)
+	if err != nil {
+		return r.record(w, StepUnwrap, nil, nil, fmt.Errorf("Failed to get WETH balance: %v", err))
+	}
+	received := new(big.Int).Sub(wethAfter, wethBefore)
+	if received.Sign() <= 0 {
+		return r.record(w, StepUnwrap, received, nil, fmt.Errorf("%w: redemption paid no WETH", ErrNothingToDo))
+	}
+
+	infoText.Printf("Unwrapping %f WETH\n", formatter.ConvertWeiToEther(received))
+	tx, err = puff.UnwrapWeth(client, w.Signer, received, r.walletConfig(w))
+	if err != nil {
+		return r.record(w, StepUnwrap, received, tx, fmt.Errorf("Failed to unwrap WETH: %v", err))
+	}
+	greenText.Printf("Successful unwrap: %s\n", tx.Url())
+	return r.record(w, StepUnwrap, received, tx, nil)
+}
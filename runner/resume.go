@@ -0,0 +1,102 @@
+package runner
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"puffDep/journal"
+	"puffDep/wallet"
+)
+
+// resumePoint is where a wallet picks up an interrupted run
+type resumePoint struct {
+	// step is the first step to run, the enabled steps before it went through
+	step string
+	// deposited is the Karak vaults the run already deposited into
+	deposited map[string]bool
+}
+
+// CompletedWallets returns the wallets the run is done with: the ones Run recorded as done, and the
+// ones whose latest record of the run is a skip, they had nothing to do. A wallet that stopped
+// anywhere in its steps, e.g. after the first of two Karak deposits, has no done record and is run
+// again. records are oldest first.
+func (r *Runner) CompletedWallets(records []journal.Record, runID string, wallets []wallet.Wallet) map[common.Address]bool {
+	done := make(map[common.Address]bool)
+	latest := make(map[common.Address]journal.Record)
+	for _, rec := range records {
+		if rec.RunID != runID {
+			continue
+		}
+		address := common.HexToAddress(rec.Wallet)
+		latest[address] = rec
+		if rec.Step == StepWalletDone && rec.Status == journal.StatusSuccess {
+			done[address] = true
+		}
+	}
+
+	completed := make(map[common.Address]bool)
+	for _, w := range wallets {
+		if rec, ok := latest[w.Address]; done[w.Address] || (ok && rec.Status == journal.StatusSkipped) {
+			completed[w.Address] = true
+		}
+	}
+	return completed
+}
+
+// Resume continues run runID from its records, oldest first. It returns the wallets the run isn't
+// done with and has each of them pick up at the step it stopped at.
+func (r *Runner) Resume(runID string, records []journal.Record, wallets []wallet.Wallet) []wallet.Wallet {
+	r.RunID = runID
+	completed := r.CompletedWallets(records, runID, wallets)
+	byWallet := make(map[common.Address][]journal.Record)
+	for _, rec := range records {
+		if rec.RunID == runID {
+			address := common.HexToAddress(rec.Wallet)
+			byWallet[address] = append(byWallet[address], rec)
+		}
+	}
+
+	r.resume = make(map[common.Address]resumePoint)
+	var left []wallet.Wallet
+	for _, w := range wallets {
+		if completed[w.Address] {
+			continue
+		}
+		left = append(left, w)
+		if len(byWallet[w.Address]) > 0 {
+			r.resume[w.Address] = r.resumePoint(w, byWallet[w.Address])
+		}
+	}
+	return left
+}
+
+// resumePoint finds where a wallet stopped from its records of the run. Steps run in pipeline order
+// and each one finishes before the next starts, so the steps before the last one with records went
+// through. That last step runs again, approve skips the vaults it approved already and deposit-karak
+// the ones it deposited into, unless it is a deposit-puffer that succeeded: it is one transaction and
+// running it again would deposit twice.
+func (r *Runner) resumePoint(w wallet.Wallet, records []journal.Record) resumePoint {
+	point := resumePoint{deposited: make(map[string]bool)}
+	latest := make(map[string]journal.Record)
+	for _, rec := range records {
+		latest[rec.Step] = rec
+		if rec.Step == StepDepositKarak && rec.Status == journal.StatusSuccess && rec.Target != "" {
+			point.deposited[rec.Target] = true
+		}
+	}
+
+	steps := r.enabledSteps(w)
+	for i, step := range steps {
+		rec, ok := latest[step]
+		if !ok {
+			continue
+		}
+		point.step = step
+		if step == StepDepositPuffer && rec.Status == journal.StatusSuccess {
+			// the wallet-done record is all that's missing when it was the only step
+			point.step = StepWalletDone
+			if i+1 < len(steps) {
+				point.step = steps[i+1]
+			}
+		}
+	}
+	return point
+}
@@ -0,0 +1,176 @@
+package runner
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"puffDep/config"
+	"puffDep/journal"
+	"puffDep/wallet"
+)
+
+func TestCompletedWallets(t *testing.T) {
+	r := &Runner{}
+
+	done := common.HexToAddress("0x01")
+	secondVaultFailed := common.HexToAddress("0x02")
+	retried := common.HexToAddress("0x03")
+	skipped := common.HexToAddress("0x04")
+	interrupted := common.HexToAddress("0x05")
+	approveOnly := common.HexToAddress("0x06")
+	untouched := common.HexToAddress("0x07")
+	otherRun := common.HexToAddress("0x08")
+	firstVaultInterrupted := common.HexToAddress("0x09")
+
+	rec := func(address common.Address, step string, status string) journal.Record {
+		return journal.Record{RunID: "run1", Wallet: address.Hex(), Step: step, Status: status}
+	}
+	records := []journal.Record{
+		rec(done, StepDepositPuffer, journal.StatusSuccess),
+		rec(done, StepApprove, journal.StatusSuccess),
+		rec(done, StepDepositKarak, journal.StatusSuccess),
+		rec(done, StepDepositKarak, journal.StatusSuccess),
+		rec(done, StepWalletDone, journal.StatusSuccess),
+
+		// the first of two vaults was deposited, the second failed
+		rec(secondVaultFailed, StepDepositPuffer, journal.StatusSuccess),
+		rec(secondVaultFailed, StepApprove, journal.StatusSuccess),
+		rec(secondVaultFailed, StepDepositKarak, journal.StatusSuccess),
+		rec(secondVaultFailed, StepDepositKarak, journal.StatusFailed),
+
+		// failed, then went through on the resumed run
+		rec(retried, StepDepositPuffer, journal.StatusFailed),
+		rec(retried, StepDepositPuffer, journal.StatusSuccess),
+		rec(retried, StepApprove, journal.StatusSuccess),
+		rec(retried, StepDepositKarak, journal.StatusSuccess),
+		rec(retried, StepWalletDone, journal.StatusSuccess),
+
+		rec(skipped, StepDepositPuffer, journal.StatusSkipped),
+
+		rec(interrupted, StepDepositPuffer, journal.StatusSuccess),
+		rec(interrupted, StepApprove, journal.StatusSuccess),
+
+		rec(approveOnly, StepApprove, journal.StatusSuccess),
+		rec(approveOnly, StepWalletDone, journal.StatusSuccess),
+
+		// the first of two vaults was deposited, then the process stopped
+		rec(firstVaultInterrupted, StepDepositPuffer, journal.StatusSuccess),
+		rec(firstVaultInterrupted, StepApprove, journal.StatusSuccess),
+		rec(firstVaultInterrupted, StepApprove, journal.StatusSuccess),
+		rec(firstVaultInterrupted, StepDepositKarak, journal.StatusSuccess),
+
+		{RunID: "run0", Wallet: otherRun.Hex(), Step: StepDepositKarak, Status: journal.StatusSuccess},
+	}
+
+	wallets := []wallet.Wallet{
+		{Address: done},
+		{Address: secondVaultFailed},
+		{Address: retried},
+		{Address: skipped},
+		{Address: interrupted},
+		{Address: approveOnly, Overrides: config.Overrides{Steps: []string{StepDepositPuffer, StepApprove}}},
+		{Address: untouched},
+		{Address: otherRun},
+		{Address: firstVaultInterrupted},
+	}
+	completed := r.CompletedWallets(records, "run1", wallets)
+
+	want := map[common.Address]bool{done: true, retried: true, skipped: true, approveOnly: true}
+	for _, w := range wallets {
+		if completed[w.Address] != want[w.Address] {
+			t.Errorf("%s completed = %v, want %v", w.Address.Hex(), completed[w.Address], want[w.Address])
+		}
+	}
+}
+
+func TestResumeStartsAtStoppedStep(t *testing.T) {
+	var cfg config.Config
+	cfg.Ethereum.Workflow.Steps = config.PipelineSteps
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	j, err := journal.Open(path, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { j.Close() })
+
+	ran := make(map[common.Address][]string)
+	r := &Runner{Config: config.NewHolder(&cfg), Journal: j}
+	r.pipeline = make(map[string]func(wallet.Wallet) error)
+	for _, step := range config.PipelineSteps {
+		r.pipeline[step] = func(w wallet.Wallet) error {
+			ran[w.Address] = append(ran[w.Address], step)
+			return nil
+		}
+	}
+
+	approveFailed := common.HexToAddress("0x01")
+	approveInterrupted := common.HexToAddress("0x02")
+	karakFailed := common.HexToAddress("0x03")
+	firstVaultInterrupted := common.HexToAddress("0x04")
+	pufferInterrupted := common.HexToAddress("0x05")
+	untouched := common.HexToAddress("0x06")
+
+	rec := func(address common.Address, step string, status string, target string) journal.Record {
+		return journal.Record{RunID: "run1", Wallet: address.Hex(), Step: step, Status: status, Target: target}
+	}
+	records := []journal.Record{
+		rec(approveFailed, StepDepositPuffer, journal.StatusSuccess, ""),
+		rec(approveFailed, StepApprove, journal.StatusFailed, "vault1"),
+
+		rec(approveInterrupted, StepDepositPuffer, journal.StatusSuccess, ""),
+		rec(approveInterrupted, StepApprove, journal.StatusSuccess, "vault1"),
+
+		rec(karakFailed, StepDepositPuffer, journal.StatusSuccess, ""),
+		rec(karakFailed, StepApprove, journal.StatusSuccess, "vault1"),
+		rec(karakFailed, StepDepositKarak, journal.StatusFailed, "vault1"),
+
+		rec(firstVaultInterrupted, StepDepositPuffer, journal.StatusSuccess, ""),
+		rec(firstVaultInterrupted, StepApprove, journal.StatusSuccess, "vault1"),
+		rec(firstVaultInterrupted, StepApprove, journal.StatusSuccess, "vault2"),
+		rec(firstVaultInterrupted, StepDepositKarak, journal.StatusSuccess, "vault1"),
+
+		// stopped during the block delay after the deposit
+		rec(pufferInterrupted, StepDepositPuffer, journal.StatusSuccess, ""),
+	}
+	wallets := []wallet.Wallet{
+		{Address: approveFailed},
+		{Address: approveInterrupted},
+		{Address: karakFailed},
+		{Address: firstVaultInterrupted},
+		{Address: pufferInterrupted},
+		{Address: untouched},
+	}
+	left := r.Resume("run1", records, wallets)
+	if len(left) != len(wallets) {
+		t.Fatalf("resuming %d wallets, want all %d", len(left), len(wallets))
+	}
+	r.Run(left)
+
+	want := map[common.Address][]string{
+		approveFailed:         {StepApprove, StepDepositKarak},
+		approveInterrupted:    {StepApprove, StepDepositKarak},
+		karakFailed:           {StepDepositKarak},
+		firstVaultInterrupted: {StepDepositKarak},
+		pufferInterrupted:     {StepApprove, StepDepositKarak},
+		untouched:             config.PipelineSteps,
+	}
+	for _, w := range wallets {
+		if !reflect.DeepEqual(ran[w.Address], want[w.Address]) {
+			t.Errorf("%s ran %v, want %v", w.Address.Hex(), ran[w.Address], want[w.Address])
+		}
+	}
+	if deposited := r.resume[firstVaultInterrupted].deposited; !deposited["vault1"] || deposited["vault2"] {
+		t.Errorf("deposited = %v, want only vault1", deposited)
+	}
+
+	// every wallet went through, resuming again has nothing left
+	journaled, err := journal.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if left := r.Resume("run1", append(records, journaled...), wallets); len(left) != 0 {
+		t.Errorf("%d wallets left after they all went through", len(left))
+	}
+}
@@ -0,0 +1,272 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	"puffDep/config"
+	"puffDep/delayer"
+	"puffDep/formatter"
+	"puffDep/history"
+	"puffDep/journal"
+	"puffDep/karak"
+	"puffDep/metrics"
+	"puffDep/notify"
+	"puffDep/rpcroute"
+	"puffDep/wallet"
+)
+
+var greenText = color.New(color.FgGreen)
+var warningText = color.New(color.FgYellow)
+var infoText = color.New(color.FgBlue)
+
+// Pipeline step names, used in the journal
+const (
+	StepDepositPuffer  = "deposit-puffer"
+	StepApproveAsset   = "approve-asset"
+	StepApprove        = "approve"
+	StepDepositKarak   = "deposit-karak"
+	StepRevoke         = "revoke"
+	StepWithdrawStart  = "withdraw-start"
+	StepWithdrawFinish = "withdraw-finish"
+	StepRedeem         = "redeem"
+	StepUnwrap         = "unwrap"
+	StepFund           = "fund"
+	StepSweepPuffEth   = "sweep-puffeth"
+	StepSweep          = "sweep"
+	// StepWalletDone is recorded by Run once all of a wallet's steps went through
+	StepWalletDone = "wallet-done"
+)
+
+// ErrNothingToDo is returned by a step when the wallet has no balance or withdrawal to work with.
+// Run treats it as a skipped wallet rather than a failure.
+var ErrNothingToDo = errors.New("nothing to do")
+
+// Runner executes the deposit pipeline steps for wallets
+type Runner struct {
+	Client  *ethclient.Client
+	Config  *config.Holder
+	Journal *journal.Journal
+	// History, when set, stores every record next to the journal
+	History     *history.DB
+	Notifier    *notify.Dispatcher
+	Withdrawals *karak.WithdrawalStore
+	RunID       string
+
+	vaultsMu sync.Mutex
+	vaults   []karak.Vault
+
+	clients *rpcroute.Pool
+
+	// pipeline is the step of runWallet by name
+	pipeline map[string]func(wallet.Wallet) error
+	// resume is where the wallets of a resumed run pick up, see Resume
+	resume map[common.Address]resumePoint
+}
+
+func New(client *ethclient.Client, cfg *config.Holder, j *journal.Journal, n *notify.Dispatcher) *Runner {
+	r := &Runner{
+		Client:      client,
+		Config:      cfg,
+		Journal:     j,
+		Notifier:    n,
+		Withdrawals: karak.NewWithdrawalStore(cfg.Get().Karak.WithdrawalsFile),
+		RunID:       uuid.NewString(),
+		clients:     rpcroute.NewPool(client, cfg.Get().RpcUrl()),
+	}
+	r.pipeline = map[string]func(wallet.Wallet) error{
+		StepDepositPuffer: r.DepositPuffer,
+		StepApprove:       r.Approve,
+		StepDepositKarak:  r.DepositKarak,
+	}
+	return r
+}
+
+// client returns the client a wallet's reads and transactions go through: the shared one, or a
+// dedicated client dialed over the wallet's rpc and proxy on first use. A route that can't be
+// dialed fails only the wallet.
+func (r *Runner) client(w wallet.Wallet) (*ethclient.Client, error) {
+	client, err := r.clients.Client(w.Route)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to connect wallet %s: %v", w.Address.Hex(), err)
+	}
+	return client, nil
+}
+
+// walletConfig is the config with the wallet's overrides merged on top, reloads apply to it as well
+func (r *Runner) walletConfig(w wallet.Wallet) *config.Holder {
+	return r.Config.With(w.Overrides)
+}
+
+// Close flushes pending notifications, closes the journal and the wallets' own clients
+func (r *Runner) Close() {
+	r.clients.Close()
+
+	r.Notifier.Close()
+	if err := r.Journal.Close(); err != nil {
+		log.Printf("Failed to close journal: %v", err)
+	}
+}
+
+func getRandomAmount(balance *big.Int, minPercent int, maxPercent int) *big.Int {
+	rand.Seed(time.Now().UnixNano())
+	percent := minPercent
+	if maxPercent > minPercent {
+		// both ends are included, like config.Range
+		percent = rand.Intn(maxPercent-minPercent+1) + minPercent
+	}
+	// integer math, a float percentage rounds e.g. 98% of 100 down to 97
+	amount := new(big.Int).Mul(balance, big.NewInt(int64(percent)))
+	return amount.Div(amount, big.NewInt(100))
+}
+
+// record writes the outcome of a step to the journal and returns err unchanged
+func (r *Runner) record(w wallet.Wallet, step string, amount *big.Int, tx *formatter.TxResult, err error) error {
+	return r.write(journal.Record{}, w, step, amount, tx, err)
+}
+
+// recordFrom is record for a transaction sent to the wallet by from
+func (r *Runner) recordFrom(from common.Address, w wallet.Wallet, step string, amount *big.Int, tx *formatter.TxResult, err error) error {
+	return r.write(journal.Record{From: from.Hex()}, w, step, amount, tx, err)
+}
+
+// recordOn is record for a step on one deposit asset or Karak vault, amount is in its units
+func (r *Runner) recordOn(target string, w wallet.Wallet, step string, amount *big.Int, tx *formatter.TxResult, err error) error {
+	return r.write(journal.Record{Target: target}, w, step, amount, tx, err)
+}
+
+// write completes rec with the outcome of the step and writes it to the journal and the history
+func (r *Runner) write(rec journal.Record, w wallet.Wallet, step string, amount *big.Int, tx *formatter.TxResult, err error) error {
+	rec.Time = time.Now().UTC()
+	rec.RunID = r.RunID
+	rec.Wallet = w.Address.Hex()
+	rec.Step = step
+	rec.Status = journal.StatusSuccess
+	if amount != nil {
+		rec.AmountWei = amount.String()
+	}
+	rec.FromTx(tx)
+	if errors.Is(err, ErrNothingToDo) {
+		rec.Status = journal.StatusSkipped
+		rec.Error = err.Error()
+	} else if err != nil {
+		rec.Status = journal.StatusFailed
+		rec.Error = err.Error()
+
+		event := notify.Event{Type: notify.EventStepFailed, RunID: r.RunID, Wallet: rec.Wallet, Step: step, Error: rec.Error}
+		if tx != nil {
+			event.TxUrl = tx.Url()
+		}
+		r.Notifier.Notify(event)
+	}
+	observeTx(step, tx)
+	if jErr := r.Journal.Write(rec); jErr != nil {
+		log.Printf("Failed to write journal: %v", jErr)
+	}
+	if r.History != nil {
+		if hErr := r.History.Write(rec); hErr != nil {
+			log.Printf("Failed to write history: %v", hErr)
+		}
+	}
+	return err
+}
+
+// observeTx updates the transaction metrics of step, tx may be nil
+func observeTx(step string, tx *formatter.TxResult) {
+	metrics.Touch()
+	if tx == nil {
+		return
+	}
+	metrics.TxsSentTotal.WithLabelValues(step).Inc()
+	if tx.Receipt == nil {
+		return
+	}
+	metrics.TxConfirmationSeconds.WithLabelValues(step).Observe(tx.ConfirmationTime.Seconds())
+	metrics.GasUsedTotal.WithLabelValues(step).Add(float64(tx.Receipt.GasUsed))
+	if tx.Receipt.EffectiveGasPrice != nil {
+		gasCost := new(big.Int).Mul(new(big.Int).SetUint64(tx.Receipt.GasUsed), tx.Receipt.EffectiveGasPrice)
+		metrics.GasSpentEthTotal.WithLabelValues(step).Add(formatter.ConvertWeiToEther(gasCost))
+	}
+}
+
+// Run executes the full pipeline for every wallet
+func (r *Runner) Run(wallets []wallet.Wallet) {
+	summary := notify.Event{Type: notify.EventRunDone, RunID: r.RunID}
+	for i, w := range wallets {
+		warningText.Printf("Working with address: %s\n", w)
+		if !w.Overrides.IsZero() {
+			infoText.Printf("Wallet overrides: %s\n", w.Overrides)
+		}
+
+		err := r.runWallet(w)
+		switch {
+		case errors.Is(err, ErrNothingToDo):
+			warningText.Printf("Skipping %s: %v\n", w.Address.Hex(), err)
+			metrics.WalletsTotal.WithLabelValues(metrics.WalletSkipped).Inc()
+			summary.Skipped++
+		case err != nil:
+			log.Printf("%v", err)
+			metrics.WalletsTotal.WithLabelValues(metrics.WalletFailed).Inc()
+			summary.Failed++
+		default:
+			r.record(w, StepWalletDone, nil, nil, nil)
+			metrics.WalletsTotal.WithLabelValues(metrics.WalletProcessed).Inc()
+			summary.Processed++
+			r.Notifier.Notify(notify.Event{Type: notify.EventWalletDone, RunID: r.RunID, Wallet: w.Address.Hex()})
+		}
+
+		//! Delay Wallets
+		if i < len(wallets)-1 {
+			delayer.DelayWallet(r.Config)
+		}
+	}
+	r.Notifier.Notify(summary)
+}
+
+// enabledSteps returns the steps enabled for the wallet, in pipeline order
+func (r *Runner) enabledSteps(w wallet.Wallet) []string {
+	enabled := make(map[string]bool)
+	for _, step := range r.walletConfig(w).Get().Ethereum.Workflow.Steps {
+		enabled[step] = true
+	}
+	var steps []string
+	for _, step := range config.PipelineSteps {
+		if enabled[step] {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+// runWallet runs the wallet's steps in pipeline order, with a block delay between them. A wallet of
+// a resumed run starts at the step it stopped at.
+func (r *Runner) runWallet(w wallet.Wallet) error {
+	from := r.resume[w.Address].step
+	ran := false
+	for _, step := range r.enabledSteps(w) {
+		if from != "" && step != from {
+			continue
+		}
+		from = ""
+		//! Delay Blocks
+		if ran {
+			delayer.DelayBlock(r.Config)
+		}
+		ran = true
+
+		delayer.WaitWhilePaused(r.Config)
+		if err := r.pipeline[step](w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
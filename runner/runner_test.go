@@ -0,0 +1,25 @@
+package runner
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGetRandomAmountIncludesMax(t *testing.T) {
+	balance := big.NewInt(100)
+	seen := map[int64]bool{}
+	for i := 0; i < 200; i++ {
+		amount := getRandomAmount(balance, 98, 99).Int64()
+		if amount < 98 || amount > 99 {
+			t.Fatalf("amount %d is outside [98, 99]", amount)
+		}
+		seen[amount] = true
+	}
+	if !seen[98] || !seen[99] {
+		t.Errorf("got amounts %v, want both ends of the range", seen)
+	}
+
+	if amount := getRandomAmount(balance, 100, 100).Int64(); amount != 100 {
+		t.Errorf("amount of a single value range = %d, want 100", amount)
+	}
+}
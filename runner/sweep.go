@@ -0,0 +1,87 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"puffDep/formatter"
+	"puffDep/multicall"
+	"puffDep/puff"
+	"puffDep/wallet"
+)
+
+// Sweep moves the wallet's puffETH, when sweep.puffEth is set, and then all of its ETH less the
+// gas cost to destination. Balances below the configured minimums are left in the wallet, as is the
+// unused gas of a contract destination (see formatter.TransferAll), which a later sweep picks up
+// once it reaches sweep.minEth.
+func (r *Runner) Sweep(w wallet.Wallet, destination common.Address) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.record(w, StepSweep, nil, nil, err)
+	}
+
+	if w.Address == destination {
+		return r.record(w, StepSweep, nil, nil, fmt.Errorf("%w: wallet is the sweep destination", ErrNothingToDo))
+	}
+	sweep := r.Config.Get().Sweep
+
+	puffEth := common.HexToAddress(puff.EthPuffTokenContractAddress)
+	read, err := multicall.ReadBalances(client, []common.Address{w.Address}, multicall.Query{Eth: true, Tokens: []common.Address{puffEth}})
+	if err == nil {
+		err = read[0].Err
+	}
+	if err != nil {
+		return r.record(w, StepSweep, nil, nil, fmt.Errorf("Failed to get balances: %v", err))
+	}
+	balances := read[0]
+
+	//! puffETH first, its transfer is paid from the ETH about to be swept
+	if sweep.PuffEth {
+		err := r.sweepPuffEth(w, destination, balances.Token(puffEth), formatter.ConvertEtherToWei(sweep.MinPuffEth))
+		if err != nil && !errors.Is(err, ErrNothingToDo) {
+			return err
+		}
+	}
+
+	//! ETH
+	if balances.Eth.Cmp(formatter.ConvertEtherToWei(sweep.MinEth)) < 0 {
+		return r.record(w, StepSweep, nil, nil, fmt.Errorf("%w: ETH balance %f is below sweep.minEth", ErrNothingToDo, formatter.ConvertWeiToEther(balances.Eth)))
+	}
+
+	if err := formatter.CheckGasPrice(client, r.walletConfig(w)); err != nil {
+		return r.record(w, StepSweep, nil, nil, err)
+	}
+
+	infoText.Printf("Sweeping ETH to %s\n", destination.Hex())
+	tx, value, err := formatter.TransferAll(client, w.Signer, destination)
+	if err != nil {
+		return r.record(w, StepSweep, value, tx, fmt.Errorf("Failed to sweep ETH: %v", err))
+	}
+	greenText.Printf("Swept %f ETH: %s\n", formatter.ConvertWeiToEther(value), tx.Url())
+	if left, err := client.BalanceAt(context.Background(), w.Address, nil); err == nil && left.Sign() > 0 {
+		warningText.Printf("%s wei stayed in the wallet, the destination used less gas than estimated\n", left)
+	}
+	return r.record(w, StepSweep, value, tx, nil)
+}
+
+func (r *Runner) sweepPuffEth(w wallet.Wallet, destination common.Address, balance *big.Int, minimum *big.Int) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.record(w, StepSweepPuffEth, balance, nil, err)
+	}
+
+	if balance.Sign() == 0 || balance.Cmp(minimum) < 0 {
+		return r.record(w, StepSweepPuffEth, balance, nil, fmt.Errorf("%w: puffETH balance %f is below sweep.minPuffEth", ErrNothingToDo, formatter.ConvertWeiToEther(balance)))
+	}
+
+	infoText.Printf("Sweeping %f puffETH to %s\n", formatter.ConvertWeiToEther(balance), destination.Hex())
+	tx, err := puff.TransferPuffEth(client, w.Signer, destination, balance, r.walletConfig(w))
+	if err != nil {
+		return r.record(w, StepSweepPuffEth, balance, tx, fmt.Errorf("Failed to sweep puffETH: %v", err))
+	}
+	greenText.Printf("Successful puffETH transfer: %s\n", tx.Url())
+	return r.record(w, StepSweepPuffEth, balance, tx, nil)
+}
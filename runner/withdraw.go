@@ -0,0 +1,102 @@
+package runner
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"puffDep/formatter"
+	"puffDep/karak"
+	"puffDep/wallet"
+)
+
+// StartWithdraw queues a withdrawal of all shares the wallet holds in every Karak vault and records them
+func (r *Runner) StartWithdraw(w wallet.Wallet) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.record(w, StepWithdrawStart, nil, nil, err)
+	}
+
+	vaults, err := r.karakVaults()
+	if err != nil {
+		return r.record(w, StepWithdrawStart, nil, nil, err)
+	}
+
+	started := 0
+	for _, v := range vaults {
+		shares, err := karak.GetVaultShares(client, v, w.Address)
+		if err != nil {
+			return r.recordOn(v.Name, w, StepWithdrawStart, nil, nil, fmt.Errorf("Failed to get shares of vault %s: %v", v.Name, err))
+		}
+		if shares.Sign() == 0 {
+			continue
+		}
+
+		infoText.Printf("Starting withdrawal of %f shares from Karak vault %s\n", formatter.ConvertWeiToEther(shares), v.Name)
+		tx, queued, err := karak.StartWithdraw(client, w.Signer, v, shares, r.walletConfig(w))
+		if err != nil {
+			return r.recordOn(v.Name, w, StepWithdrawStart, shares, tx, fmt.Errorf("Failed to start withdrawal from vault %s: %v", v.Name, err))
+		}
+
+		root, err := queued.Root()
+		if err != nil {
+			return r.recordOn(v.Name, w, StepWithdrawStart, shares, tx, err)
+		}
+		if err := r.Withdrawals.Add(karak.Withdrawal{Wallet: w.Address, Supervisor: v.Supervisor, Root: root, Queued: *queued, StartTx: tx.Hash.Hex()}); err != nil {
+			return r.recordOn(v.Name, w, StepWithdrawStart, shares, tx, fmt.Errorf("Withdrawal %s started but not recorded: %v", root.Hex(), err))
+		}
+
+		greenText.Printf("Withdrawal started: %s, root %s, nonce %s, ready at %s\n", tx.Url(), root.Hex(), queued.Nonce, queued.MaturesAt(karak.WithdrawalDelay(r.Config.Get())).Format(time.RFC3339))
+		if err := r.recordOn(v.Name, w, StepWithdrawStart, shares, tx, nil); err != nil {
+			return err
+		}
+		started++
+	}
+
+	if started == 0 {
+		return r.record(w, StepWithdrawStart, nil, nil, fmt.Errorf("%w: no Karak shares", ErrNothingToDo))
+	}
+	return nil
+}
+
+// FinishWithdraw finishes every recorded withdrawal of the wallet that is past the withdrawal delay
+func (r *Runner) FinishWithdraw(w wallet.Wallet) error {
+	client, err := r.client(w)
+	if err != nil {
+		return r.record(w, StepWithdrawFinish, nil, nil, err)
+	}
+
+	pending, err := r.Withdrawals.Pending(w.Address)
+	if err != nil {
+		return r.record(w, StepWithdrawFinish, nil, nil, err)
+	}
+
+	finished := 0
+	for _, p := range pending {
+		maturesAt := p.Queued.MaturesAt(karak.WithdrawalDelay(r.Config.Get()))
+		if time.Now().Before(maturesAt) {
+			warningText.Printf("Withdrawal %s is not ready until %s\n", p.Root.Hex(), maturesAt.Format(time.RFC3339))
+			continue
+		}
+
+		infoText.Printf("Finishing withdrawal %s\n", p.Root.Hex())
+		shares := p.Queued.Request.Shares[0]
+		tx, err := karak.FinishWithdraw(client, w.Signer, p.Supervisor, &p.Queued, r.walletConfig(w))
+		if err != nil {
+			return r.record(w, StepWithdrawFinish, shares, tx, fmt.Errorf("Failed to finish withdrawal %s: %v", p.Root.Hex(), err))
+		}
+		if err := r.Withdrawals.MarkFinished(p.Root, tx.Hash.Hex()); err != nil {
+			log.Printf("Withdrawal %s finished but not recorded: %v", p.Root.Hex(), err)
+		}
+		greenText.Printf("Withdrawal finished: %s\n", tx.Url())
+		if err := r.record(w, StepWithdrawFinish, shares, tx, nil); err != nil {
+			return err
+		}
+		finished++
+	}
+
+	if finished == 0 {
+		return r.record(w, StepWithdrawFinish, nil, nil, fmt.Errorf("%w: no matured withdrawals", ErrNothingToDo))
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package signer
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+// LoadKeystore decrypts a V3 keystore file with password
+func LoadKeystore(path string, password string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %v", err)
+	}
+	decrypted, err := keystore.DecryptKey(data, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore %s: %v", path, err)
+	}
+	return FromKey(decrypted.PrivateKey), nil
+}
@@ -0,0 +1,105 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Remote signs through eth_signTransaction of a signing service such as Clef or Web3Signer,
+// the key never reaches this process
+type Remote struct {
+	client  *rpc.Client
+	address common.Address
+}
+
+func NewRemote(client *rpc.Client, address common.Address) *Remote {
+	return &Remote{client: client, address: address}
+}
+
+func (r *Remote) Address() common.Address {
+	return r.address
+}
+
+// sendTxArgs are the eth_signTransaction arguments of a dynamic fee transaction
+type sendTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	Input                hexutil.Bytes   `json:"input"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+func (r *Remote) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if tx.Type() != types.DynamicFeeTxType {
+		return nil, fmt.Errorf("remote signer only signs dynamic fee transactions, got type %d", tx.Type())
+	}
+	args := sendTxArgs{
+		From:                 r.address,
+		To:                   tx.To(),
+		Gas:                  hexutil.Uint64(tx.Gas()),
+		MaxFeePerGas:         (*hexutil.Big)(tx.GasFeeCap()),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tx.GasTipCap()),
+		Value:                (*hexutil.Big)(tx.Value()),
+		Nonce:                hexutil.Uint64(tx.Nonce()),
+		Data:                 tx.Data(),
+		Input:                tx.Data(),
+		ChainID:              (*hexutil.Big)(chainID),
+	}
+
+	// Clef answers with {raw, tx}, Web3Signer with the raw transaction only
+	var result json.RawMessage
+	if err := r.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign for %s: %v", r.address.Hex(), err)
+	}
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var clef struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err := json.Unmarshal(result, &clef); err != nil || len(clef.Raw) == 0 {
+			return nil, fmt.Errorf("unexpected remote signer response: %s", strings.TrimSpace(string(result)))
+		}
+		raw = clef.Raw
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction from remote signer: %v", err)
+	}
+	if err := r.verify(tx, signed, chainID); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+// verify checks that the remote signer signed what was asked, for our address
+func (r *Remote) verify(tx *types.Transaction, signed *types.Transaction, chainID *big.Int) error {
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	if err != nil {
+		return fmt.Errorf("failed to recover remote signer's sender: %v", err)
+	}
+	if sender != r.address {
+		return fmt.Errorf("remote signer signed as %s, expected %s", sender.Hex(), r.address.Hex())
+	}
+	if signed.Nonce() != tx.Nonce() || signed.Gas() != tx.Gas() || signed.Value().Cmp(tx.Value()) != 0 ||
+		signed.GasFeeCap().Cmp(tx.GasFeeCap()) != 0 || signed.GasTipCap().Cmp(tx.GasTipCap()) != 0 ||
+		!bytes.Equal(signed.Data(), tx.Data()) || (signed.To() == nil) != (tx.To() == nil) ||
+		(tx.To() != nil && *signed.To() != *tx.To()) {
+		return fmt.Errorf("remote signer returned a different transaction than requested")
+	}
+	return nil
+}
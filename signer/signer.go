@@ -0,0 +1,95 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"puffDep/config"
+)
+
+// Signer signs transactions for a single address. The key may be held in memory or by a
+// separate signing service.
+type Signer interface {
+	Address() common.Address
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// Key signs with a private key held in memory
+type Key struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+func FromKey(key *ecdsa.PrivateKey) *Key {
+	return &Key{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// ParseKey parses a hex private key, with or without the 0x prefix
+func ParseKey(hexKey string) (*Key, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, err
+	}
+	return FromKey(key), nil
+}
+
+func (k *Key) Address() common.Address {
+	return k.address
+}
+
+func (k *Key) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), k.key)
+}
+
+// Options are what Parse needs for references that aren't plain keys
+type Options struct {
+	// KeystorePassword decrypts keystore: references
+	KeystorePassword string
+	// Remote signs for remote: references
+	Remote *rpc.Client
+}
+
+// Parse resolves a key reference: a hex private key, keystore:<path> of a V3 keystore file,
+// or remote:<address> for an account of the remote signer
+func Parse(ref string, opts Options) (Signer, error) {
+	ref = strings.TrimSpace(ref)
+	kind, value, found := strings.Cut(ref, ":")
+	if !found {
+		return ParseKey(ref)
+	}
+
+	switch kind {
+	case "keystore":
+		return LoadKeystore(value, opts.KeystorePassword)
+	case "remote":
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("remote signer account %q is not a valid address", value)
+		}
+		if opts.Remote == nil {
+			return nil, fmt.Errorf("remote signer account %s needs signer.remoteUrl", value)
+		}
+		return NewRemote(opts.Remote, common.HexToAddress(value)), nil
+	default:
+		return nil, fmt.Errorf("unknown key reference %q, expected a private key, keystore:<path> or remote:<address>", kind)
+	}
+}
+
+// OptionsFromConfig reads the keystore password and connects to the remote signer of the config
+func OptionsFromConfig(cfg *config.Config) (Options, error) {
+	opts := Options{KeystorePassword: cfg.Signer.KeystorePassword}
+	if cfg.Signer.RemoteUrl != "" {
+		client, err := rpc.Dial(cfg.Signer.RemoteUrl)
+		if err != nil {
+			return opts, fmt.Errorf("Failed to connect to the remote signer: %v", err)
+		}
+		opts.Remote = client
+	}
+	return opts, nil
+}
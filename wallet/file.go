@@ -0,0 +1,260 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+	"puffDep/config"
+	"puffDep/rpcroute"
+	"puffDep/signer"
+)
+
+// Entry is one wallet of a wallet file
+type Entry struct {
+	// Key is a key reference, see signer.Parse
+	Key   string   `yaml:"key"`
+	Label string   `yaml:"label"`
+	Tags  []string `yaml:"tags"`
+	// Address, when set, has to be the address of Key
+	Address string `yaml:"address"`
+	Rpc     string `yaml:"rpc"`
+	Proxy   string `yaml:"proxy"`
+	// Overrides are merged onto the config for this wallet
+	Overrides config.Overrides `yaml:"overrides"`
+
+	// index is the wallet index, where locates the entry in error messages
+	index int
+	where string
+}
+
+// LoadError lists every problem found in a wallet file
+type LoadError struct {
+	File     string
+	Problems []string
+}
+
+func (e *LoadError) Error() string {
+	return fmt.Sprintf("invalid wallet file %s:\n  %s", e.File, strings.Join(e.Problems, "\n  "))
+}
+
+// csvColumns are the columns a csv wallet file may have, key is required. The override columns
+// are flat: amountMinPercent and amountMaxPercent make workAmountRangePercent, steps are separated by ;
+var csvColumns = map[string]bool{
+	"key": true, "label": true, "tags": true, "address": true, "rpc": true, "proxy": true,
+	"amountminpercent": true, "amountmaxpercent": true, "depositasset": true, "karakvault": true,
+	"gweilimit": true, "steps": true, "receiver": true,
+}
+
+// readEntries reads the wallet file by extension: .csv with a header row, .yaml or .yml with a
+// wallets list, anything else one key reference per line
+func readEntries(filename string) ([]Entry, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return readCSV(string(data))
+	case ".yaml", ".yml":
+		return readYAML(data)
+	default:
+		return readLines(string(data)), nil
+	}
+}
+
+// readLines takes one key, or proxy, per line, skipping blank lines and # comments. The index is the line.
+func readLines(data string) []Entry {
+	var entries []Entry
+	for i, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, Entry{Key: line, index: i, where: fmt.Sprintf("line %d", i+1)})
+	}
+	return entries
+}
+
+// readCSV reads a csv file whose header names the columns, tags are separated by ;
+func readCSV(data string) ([]Entry, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %v", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !csvColumns[name] {
+			return nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["key"]; !ok {
+		return nil, fmt.Errorf("csv header has no key column")
+	}
+
+	var entries []Entry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %v", err)
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		entry := Entry{
+			Key:     field("key"),
+			Label:   field("label"),
+			Tags:    splitList(field("tags")),
+			Address: field("address"),
+			Rpc:     field("rpc"),
+			Proxy:   field("proxy"),
+			index:   len(entries),
+			where:   fmt.Sprintf("line %d", line),
+		}
+		o := &entry.Overrides
+		o.DepositAsset = field("depositasset")
+		o.KarakVault = field("karakvault")
+		o.Steps = splitList(field("steps"))
+		o.Receiver = field("receiver")
+		if o.GweiLimit, err = csvInt(field("gweilimit")); err != nil {
+			return nil, fmt.Errorf("line %d: gweiLimit: %v", line, err)
+		}
+		if lo, hi := field("amountminpercent"), field("amountmaxpercent"); lo != "" || hi != "" {
+			var r config.Range
+			if r.Min, err = csvInt(lo); err != nil {
+				return nil, fmt.Errorf("line %d: amountMinPercent: %v", line, err)
+			}
+			if r.Max, err = csvInt(hi); err != nil {
+				return nil, fmt.Errorf("line %d: amountMaxPercent: %v", line, err)
+			}
+			o.WorkAmountRangePercent = &r
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// splitList splits a ; separated csv field, dropping empty items
+func splitList(field string) []string {
+	var items []string
+	for _, item := range strings.Split(field, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// csvInt parses an optional integer field, empty is 0
+func csvInt(field string) (int, error) {
+	if field == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(field)
+}
+
+// readYAML reads a yaml file with a top level wallets list, unknown fields are an error
+func readYAML(data []byte) ([]Entry, error) {
+	var file struct {
+		Wallets []Entry `yaml:"wallets"`
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to parse yaml: %v", err)
+	}
+	for i := range file.Wallets {
+		file.Wallets[i].index = i
+		file.Wallets[i].where = fmt.Sprintf("wallets[%d]", i)
+	}
+	return file.Wallets, nil
+}
+
+// build resolves every entry into a wallet and checks the whole list: keys, expected addresses,
+// duplicates, routes and overrides. All problems are returned together.
+func build(filename string, entries []Entry, cfg *config.Config, opts signer.Options) ([]Wallet, error) {
+	problems := &LoadError{File: filename}
+	fail := func(e Entry, format string, args ...interface{}) {
+		problems.Problems = append(problems.Problems, e.where+": "+fmt.Sprintf(format, args...))
+	}
+
+	wallets := make([]Wallet, 0, len(entries))
+	seen := make(map[common.Address]string)
+	for _, e := range entries {
+		if e.Key == "" {
+			fail(e, "key is required")
+			continue
+		}
+		s, err := signer.Parse(e.Key, opts)
+		if err != nil {
+			fail(e, "%v", err)
+			continue
+		}
+		address := s.Address()
+
+		if e.Address != "" {
+			if !common.IsHexAddress(e.Address) {
+				fail(e, "%q is not a valid address", e.Address)
+			} else if common.HexToAddress(e.Address) != address {
+				fail(e, "key is for %s, expected %s", address.Hex(), e.Address)
+			}
+		}
+		if first, ok := seen[address]; ok {
+			fail(e, "%s is already listed at %s", address.Hex(), first)
+			continue
+		}
+		seen[address] = e.where
+
+		if e.Rpc != "" {
+			if err := checkRpc(e.Rpc); err != nil {
+				fail(e, "%v", err)
+			}
+		}
+		if e.Proxy != "" {
+			if err := checkProxy(e.Proxy); err != nil {
+				fail(e, "%v", err)
+			}
+		}
+		for _, problem := range cfg.ValidateOverrides(e.Overrides) {
+			fail(e, "overrides.%s", problem)
+		}
+
+		wallets = append(wallets, Wallet{
+			Index:     e.index,
+			Signer:    s,
+			Address:   address,
+			Label:     e.Label,
+			Tags:      e.Tags,
+			Route:     rpcroute.Route{Rpc: e.Rpc, Proxy: e.Proxy},
+			Overrides: e.Overrides,
+		})
+	}
+
+	if len(problems.Problems) > 0 {
+		return nil, problems
+	}
+	if len(wallets) == 0 {
+		return nil, fmt.Errorf("no wallets in %s", filename)
+	}
+	return wallets, nil
+}
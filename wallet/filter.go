@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Filter selects wallets by address, by index and/or by tag
+type Filter struct {
+	Addresses []string
+	Indexes   string
+	// Tags matches wallets having any of them
+	Tags []string
+}
+
+// Apply returns the wallets matching the filter, an empty filter matches everything
+func (f Filter) Apply(wallets []Wallet) ([]Wallet, error) {
+	addresses := make(map[common.Address]bool)
+	for _, a := range f.Addresses {
+		if !common.IsHexAddress(a) {
+			return nil, fmt.Errorf("invalid wallet address %q", a)
+		}
+		addresses[common.HexToAddress(a)] = true
+	}
+
+	indexes, err := parseIndexes(f.Indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Wallet
+	for _, w := range wallets {
+		if len(addresses) > 0 && !addresses[w.Address] {
+			continue
+		}
+		if indexes != nil && !indexes[w.Index] {
+			continue
+		}
+		if len(f.Tags) > 0 && !hasAnyTag(w, f.Tags) {
+			continue
+		}
+		result = append(result, w)
+	}
+	return result, nil
+}
+
+func hasAnyTag(w Wallet, tags []string) bool {
+	for _, tag := range tags {
+		if w.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIndexes parses a list like "0-4,7,9" into a set of indexes
+func parseIndexes(spec string) (map[int]bool, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+
+	indexes := make(map[int]bool)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		from, to, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid wallet index %q", part)
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(to)
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid wallet index range %q", part)
+			}
+		}
+		for i := start; i <= end; i++ {
+			indexes[i] = true
+		}
+	}
+	return indexes, nil
+}
@@ -0,0 +1,66 @@
+package wallet
+
+import (
+	"fmt"
+	"sort"
+
+	"puffDep/config"
+	"puffDep/hd"
+	"puffDep/signer"
+)
+
+// Mnemonic returns the configured mnemonic, decrypting hd.mnemonicFile when it is used
+func Mnemonic(cfg *config.Config) (string, error) {
+	if cfg.HD.MnemonicFile != "" {
+		return hd.ReadMnemonicFile(cfg.HD.MnemonicFile, cfg.HD.Password)
+	}
+	if cfg.HD.Mnemonic == "" {
+		return "", fmt.Errorf("no mnemonic configured, set hd.mnemonic or hd.mnemonicFile")
+	}
+	return cfg.HD.Mnemonic, nil
+}
+
+// Derive derives the wallets of hd.indexes from the configured mnemonic. The wallet index is the
+// derivation index.
+func Derive(cfg *config.Config) ([]Wallet, error) {
+	mnemonic, err := Mnemonic(cfg)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := parseIndexes(cfg.HD.Indexes)
+	if err != nil {
+		return nil, fmt.Errorf("hd.indexes: %v", err)
+	}
+	if len(indexes) == 0 {
+		return nil, fmt.Errorf("hd.indexes selects no wallets")
+	}
+	sorted := make([]int, 0, len(indexes))
+	for i := range indexes {
+		sorted = append(sorted, i)
+	}
+	sort.Ints(sorted)
+	return DeriveIndexes(mnemonic, cfg.HD.Passphrase, cfg.HD.Path, sorted)
+}
+
+// DeriveIndexes derives the wallets at indexes of the path template
+func DeriveIndexes(mnemonic string, passphrase string, pathTemplate string, indexes []int) ([]Wallet, error) {
+	seed, err := hd.Seed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	wallets := make([]Wallet, 0, len(indexes))
+	for _, i := range indexes {
+		path, err := hd.PathFor(pathTemplate, i)
+		if err != nil {
+			return nil, err
+		}
+		key, err := hd.Derive(seed, path)
+		if err != nil {
+			return nil, err
+		}
+		s := signer.FromKey(key)
+		wallets = append(wallets, Wallet{Index: i, Signer: s, Address: s.Address()})
+	}
+	return wallets, nil
+}
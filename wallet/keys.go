@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"puffDep/config"
+	"puffDep/rpcroute"
+	"puffDep/signer"
+)
+
+// Wallet is a single key together with its index: its line of a keys file, its entry of a wallet
+// list or its derivation index
+type Wallet struct {
+	Index   int
+	Signer  signer.Signer
+	Address common.Address
+	Label   string
+	Tags    []string
+	// Route is the wallet's own rpc and/or proxy, the zero Route uses the shared client
+	Route rpcroute.Route
+	// Overrides are the wallet's own workflow settings, see Runner.walletConfig
+	Overrides config.Overrides
+}
+
+// String is the address, followed by the label when the wallet has one
+func (w Wallet) String() string {
+	if w.Label == "" {
+		return w.Address.Hex()
+	}
+	return fmt.Sprintf("%s (%s)", w.Address.Hex(), w.Label)
+}
+
+// HasTag reports whether the wallet is tagged with tag
+func (w Wallet) HasTag(tag string) bool {
+	for _, t := range w.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Load reads the wallet file and resolves every key reference. The file is a csv or yaml wallet
+// list, or one key reference per line (see signer.Parse). The whole list is checked and any problem
+// fails the load, so nothing is sent with a partly valid list.
+func Load(filename string, cfg *config.Config, opts signer.Options) ([]Wallet, error) {
+	entries, err := readEntries(filename)
+	if err != nil {
+		return nil, err
+	}
+	return build(filename, entries, cfg, opts)
+}
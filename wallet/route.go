@@ -0,0 +1,84 @@
+package wallet
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"puffDep/config"
+	"puffDep/rpcroute"
+)
+
+// ReadProxies reads one proxy url per line of the proxies file, the proxy of a wallet is the one on
+// its line. Blank and # comment lines leave the wallet on that line without a proxy, every other
+// line has to be a http, https or socks5 url.
+func ReadProxies(filename string) ([]string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var proxies []string
+	for _, e := range readLines(string(data)) {
+		if err := checkProxy(e.Key); err != nil {
+			return nil, fmt.Errorf("%s %s: %v", filename, e.where, err)
+		}
+		for len(proxies) <= e.index {
+			proxies = append(proxies, "")
+		}
+		proxies[e.index] = e.Key
+	}
+	return proxies, nil
+}
+
+func checkProxy(proxy string) error {
+	return checkUrl("proxy", proxy, rpcroute.ProxySchemes)
+}
+
+func checkRpc(rpc string) error {
+	return checkUrl("rpc", rpc, rpcroute.RpcSchemes)
+}
+
+func checkUrl(kind string, raw string, schemes []string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%s is not a valid %s url", rpcroute.Redact(raw), kind)
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s scheme must be one of %s, got %q", kind, strings.Join(schemes, ", "), u.Scheme)
+}
+
+// ApplyRoutes sets the route of every wallet: the proxy on its line of routing.proxiesFile unless
+// the wallet file gave it one, overridden by the routing.wallets entry of its address
+func ApplyRoutes(wallets []Wallet, cfg *config.Config) error {
+	var proxies []string
+	if cfg.Routing.ProxiesFile != "" {
+		var err error
+		if proxies, err = ReadProxies(cfg.Routing.ProxiesFile); err != nil {
+			return fmt.Errorf("Error reading proxies: %v", err)
+		}
+	}
+
+	for i := range wallets {
+		w := &wallets[i]
+		if w.Route.Proxy == "" && w.Index < len(proxies) {
+			w.Route.Proxy = proxies[w.Index]
+		}
+		for address, route := range cfg.Routing.Wallets {
+			if !strings.EqualFold(address, w.Address.Hex()) {
+				continue
+			}
+			if route.Rpc != "" {
+				w.Route.Rpc = route.Rpc
+			}
+			if route.Proxy != "" {
+				w.Route.Proxy = route.Proxy
+			}
+		}
+	}
+	return nil
+}